@@ -0,0 +1,68 @@
+// Package audit reports on the strength of the passwords in the vault.
+package audit
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ejcx/passgo/v2/format"
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+const (
+	// WeakScore is the highest pc.Strength score that is considered weak.
+	WeakScore = 2
+)
+
+// Audit decrypts every password in the vault and prints an estimate of its
+// strength. File and SSH key entries are skipped. If weakOnly is set, only passwords
+// with a score of WeakScore or lower are printed. With a format other than
+// format.Plain the results are printed as a list of format.AuditResult.
+func Audit(weakOnly bool, f format.Format) {
+	vault := pio.GetVault()
+	masterPrivKey := pc.GetMasterKey()
+	results := []format.AuditResult{}
+	var total, weak int
+	for _, site := range vault {
+		if site.IsFile || site.SSHPublicKey != "" {
+			continue
+		}
+		pass, err := pc.OpenAsym(site.PassSealed, &site.PubKey, &masterPrivKey)
+		if err != nil {
+			log.Printf("Could not decrypt %s: %s", site.Name, err.Error())
+			continue
+		}
+		total++
+		strength := pc.EstimateStrength(string(pass), site.Name)
+		if strength.Score <= WeakScore {
+			weak++
+		} else if weakOnly {
+			continue
+		}
+		if f != format.Plain {
+			results = append(results, format.AuditResult{
+				Name:      site.Name,
+				Score:     strength.Score,
+				Strength:  strength.ScoreName(),
+				Guesses:   strength.Guesses,
+				CrackTime: strength.CrackTime.Seconds(),
+				Weak:      strength.Score <= WeakScore,
+				Warning:   strength.Warning,
+			})
+			continue
+		}
+		fmt.Printf("%s: %s\n", site.Name, strength)
+		if strength.Warning != "" {
+			fmt.Printf("    %s\n", strength.Warning)
+		}
+	}
+	if f != format.Plain {
+		if err := f.Write(os.Stdout, results); err != nil {
+			log.Fatalf("Could not print audit results: %s", err.Error())
+		}
+		return
+	}
+	fmt.Printf("%d of %d passwords are weak\n", weak, total)
+}
@@ -8,11 +8,14 @@ package edit
 import (
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"golang.org/x/crypto/nacl/box"
 
@@ -21,39 +24,103 @@ import (
 )
 
 // Remove is used to remove a site entry from the password vault given a path.
-func remove(path string) {
+func Remove(path string) error {
 	vault := pio.GetVault()
 	pathIndex := -1
 	for jj, siteInfo := range vault {
 		if siteInfo.Name == path {
 			pathIndex = jj
-			if siteInfo.IsFile {
-				encFileDir, err := pio.GetEncryptedFilesDir()
-				if err != nil {
-					log.Fatalf("Could not get encrypted file path for deleting: %s", err.Error())
-				}
-				fp := filepath.Join(encFileDir, siteInfo.FileName)
-				err = os.Remove(fp)
-				if err != nil {
-					log.Fatalf("Attempted to remove file but was unable to: %s", err.Error())
-				}
-			}
 			break
 		}
 	}
 	if pathIndex == -1 {
-		log.Fatalf("Could not find %s in vault", path)
+		return fmt.Errorf("Could not find %s in vault", path)
+	}
+	if err := removeFile(vault[pathIndex]); err != nil {
+		return err
 	}
 	vault = append(vault[:pathIndex], vault[pathIndex+1:]...)
 	err := pio.UpdateVault(vault)
 	if err != nil {
-		log.Fatalf("Could not update password vault: %s", err.Error())
+		return fmt.Errorf("Could not update password vault: %s", err.Error())
+	}
+	return nil
+}
+
+// removeFile deletes the encrypted file of a file entry.
+func removeFile(siteInfo pio.SiteInfo) error {
+	if !siteInfo.IsFile {
+		return nil
+	}
+	encFileDir, err := pio.GetEncryptedFilesDir()
+	if err != nil {
+		return fmt.Errorf("Could not get encrypted file path for deleting: %s", err.Error())
+	}
+	fp := filepath.Join(encFileDir, siteInfo.FileName)
+	err = os.Remove(fp)
+	if err != nil {
+		return fmt.Errorf("Attempted to remove file but was unable to: %s", err.Error())
+	}
+	removeEmptyDirs(encFileDir, filepath.Dir(fp))
+	return nil
+}
+
+// removeEmptyDirs removes dir and its parents below encFileDir, which are
+// the directories of nested groups, for as long as they are empty.
+func removeEmptyDirs(encFileDir, dir string) {
+	for ; dir != encFileDir && strings.HasPrefix(dir, encFileDir); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
 	}
 }
 
 // RemovePassword is called to remove a password entry.
 func RemovePassword(path string) {
-	remove(path)
+	if err := Remove(path); err != nil {
+		log.Fatalf("Could not remove %s: %s", path, err.Error())
+	}
+}
+
+// RemoveGroup removes every site in group and its subgroups after asking
+// the user to confirm.
+func RemoveGroup(group string) {
+	group = pio.CleanPath(group)
+	if group == "" {
+		log.Fatalf("Refusing to remove every site in the vault")
+	}
+	vault := pio.GetVault()
+	var keep, removed pio.SiteFile
+	for _, siteInfo := range vault {
+		if pio.InGroup(siteInfo.Name, group) {
+			removed = append(removed, siteInfo)
+		} else {
+			keep = append(keep, siteInfo)
+		}
+	}
+	if len(removed) == 0 {
+		log.Fatalf("Could not find any sites in %s", group)
+	}
+	ok, err := pio.Confirm(fmt.Sprintf("Remove %d sites in %s?", len(removed), group))
+	if err != nil {
+		log.Fatalf("Could not get confirmation from user: %s", err.Error())
+	}
+	if !ok {
+		fmt.Println("Nothing was removed.")
+		return
+	}
+	for _, siteInfo := range removed {
+		if err = removeFile(siteInfo); err != nil {
+			log.Fatalf("Could not remove %s: %s", siteInfo.Name, err.Error())
+		}
+	}
+	if keep == nil {
+		keep = pio.SiteFile{}
+	}
+	err = pio.UpdateVault(keep)
+	if err != nil {
+		log.Fatalf("Could not update password vault: %s", err.Error())
+	}
 }
 
 // Edit is used to change the password of a site. New keys MUST be generated.
@@ -61,70 +128,241 @@ func Edit(path string) {
 	vault := pio.GetVault()
 	for jj, siteInfo := range vault {
 		if siteInfo.Name == path {
-			newPass, err := pio.PromptPass(fmt.Sprintf("Enter new password for %s: ", path))
+			newPass, err := pio.PromptSecret(fmt.Sprintf("Enter new password for %s", path))
 			if err != nil {
 				log.Fatalf("Could not get new password for %s: %s", path, err)
 			}
-			newSiteInfo := reencrypt(siteInfo, newPass)
+			pc.PrintStrength(newPass, path)
+			newSiteInfo, err := reencrypt(siteInfo, newPass)
+			if err != nil {
+				log.Fatalf("Could not edit %s: %s", path, err)
+			}
 			vault[jj] = newSiteInfo
 			err = pio.UpdateVault(vault)
 			if err != nil {
+				newSiteInfo.DiscardFile()
 				log.Fatalf("Could not edit %s: %s", path, err)
 			}
+			if err = newSiteInfo.CommitFile(); err != nil {
+				log.Fatalf("Could not save encrypted file for %s: %s", path, err)
+			}
 		}
 	}
 }
 
-// Rename will take an vault name and change the name.
-func Rename(path string) {
+// Replace is used to change the password of a site to newPass without
+// prompting. New keys MUST be generated.
+func Replace(path, newPass string) error {
 	vault := pio.GetVault()
 	for jj, siteInfo := range vault {
 		if siteInfo.Name == path {
-			newName, err := pio.Prompt(fmt.Sprintf("Enter new site name for %s: ", path))
+			if siteInfo.IsFile {
+				return fmt.Errorf("%s is a file entry", path)
+			}
+			newSiteInfo, err := reencrypt(siteInfo, newPass)
 			if err != nil {
-				log.Fatalf("Could not get new site name from user: %s", err.Error())
+				return err
 			}
-			vault[jj] = pio.SiteInfo{
-				PubKey:     siteInfo.PubKey,
-				PassSealed: siteInfo.PassSealed,
-				Name:       newName,
+			vault[jj] = newSiteInfo
+			return pio.UpdateVault(vault)
+		}
+	}
+	return fmt.Errorf("Could not find %s in vault", path)
+}
+
+// Rename changes the name of a site. If path is a group rather than a
+// site, every site in the group and its subgroups is moved to the new group.
+func Rename(path string) {
+	kind, sites := findRenamed(pio.GetVault(), path)
+	if len(sites) == 0 {
+		log.Fatalf("Could not find %s in vault", path)
+	}
+	if kind == "group" {
+		path = pio.CleanPath(path)
+	}
+	newName, err := pio.Prompt(fmt.Sprintf("Enter new %s name for %s: ", kind, path))
+	if err != nil {
+		log.Fatalf("Could not get new %s name from user: %s", kind, err.Error())
+	}
+	if err = Move(path, newName); err != nil {
+		log.Fatalf("Could not rename %s: %s", path, err.Error())
+	}
+}
+
+// Move renames the site path to newName, or moves every site in the group
+// path to the group newName if there is no site called path.
+func Move(path, newName string) error {
+	vault := pio.GetVault()
+	kind, sites := findRenamed(vault, path)
+	if len(sites) == 0 {
+		return fmt.Errorf("Could not find %s in vault", path)
+	}
+	if kind == "group" {
+		path = pio.CleanPath(path)
+		newName = pio.CleanPath(newName)
+	}
+	if newName == "" {
+		return errors.New("The new name is empty")
+	}
+
+	renamed := map[int]bool{}
+	for _, jj := range sites {
+		renamed[jj] = true
+	}
+	taken := map[string]bool{}
+	takenFiles := map[string]bool{}
+	for jj, siteInfo := range vault {
+		if !renamed[jj] {
+			taken[siteInfo.Name] = true
+			if siteInfo.IsFile {
+				takenFiles[siteInfo.FileName] = true
 			}
-			err = pio.UpdateVault(vault)
-			if err != nil {
-				log.Fatalf("Could not renmae %s: %s", path, err)
+		}
+	}
+	var moves []fileMove
+	for _, jj := range sites {
+		name := newName + strings.TrimPrefix(vault[jj].Name, path)
+		if taken[name] {
+			return fmt.Errorf("A site called %s already exists", name)
+		}
+		// The encrypted file of a file entry moves with it, so that a new
+		// site with the old name can not overwrite it.
+		if vault[jj].IsFile && vault[jj].FileName != name {
+			if takenFiles[name] {
+				return fmt.Errorf("The encrypted file %s is used by another site", name)
 			}
+			moves = append(moves, fileMove{from: vault[jj].FileName, to: name})
+			vault[jj].FileName = name
+		}
+		vault[jj].Name = name
+	}
+	undo, err := moveFiles(moves)
+	if err != nil {
+		return err
+	}
+	if err = pio.UpdateVault(vault); err != nil {
+		undo()
+		return err
+	}
+	return nil
+}
+
+type fileMove struct {
+	from, to string
+}
+
+// moveFiles renames encrypted files. Every file is moved to a temporary
+// name first so that sites can take over each other's names. The returned
+// function moves the files back.
+func moveFiles(moves []fileMove) (undo func(), err error) {
+	undo = func() {}
+	if len(moves) == 0 {
+		return undo, nil
+	}
+	encFileDir, err := pio.GetEncryptedFilesDir()
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := ioutil.TempDir(encFileDir, ".move")
+	if err != nil {
+		return nil, err
+	}
+	var done []fileMove
+	undo = func() {
+		for i := len(done) - 1; i >= 0; i-- {
+			os.MkdirAll(filepath.Dir(done[i].from), 0700)
+			os.Rename(done[i].to, done[i].from)
+		}
+		os.Remove(tmp)
+	}
+	rename := func(from, to string) error {
+		if err := os.MkdirAll(filepath.Dir(to), 0700); err != nil {
+			return err
+		}
+		if err := os.Rename(from, to); err != nil {
+			return err
+		}
+		done = append(done, fileMove{from: from, to: to})
+		return nil
+	}
+	for i, m := range moves {
+		if err = rename(filepath.Join(encFileDir, m.from), filepath.Join(tmp, strconv.Itoa(i))); err != nil {
+			undo()
+			return nil, err
+		}
+	}
+	for i, m := range moves {
+		if err = rename(filepath.Join(tmp, strconv.Itoa(i)), filepath.Join(encFileDir, m.to)); err != nil {
+			undo()
+			return nil, err
+		}
+	}
+	os.Remove(tmp)
+	for _, m := range moves {
+		removeEmptyDirs(encFileDir, filepath.Dir(filepath.Join(encFileDir, m.from)))
+	}
+	return undo, nil
+}
+
+// findRenamed returns the index of the site called path, or the indexes
+// of every site in the group path if there is no such site.
+func findRenamed(vault pio.SiteFile, path string) (kind string, sites []int) {
+	for jj, siteInfo := range vault {
+		if siteInfo.Name == path {
+			return "site", []int{jj}
 		}
 	}
+	group := pio.CleanPath(path)
+	if group == "" {
+		return "group", nil
+	}
+	for jj, siteInfo := range vault {
+		if pio.InGroup(siteInfo.Name, group) {
+			sites = append(sites, jj)
+		}
+	}
+	return "group", sites
 }
 
-// reencrypt takes in a SiteInfo and will return a new SiteInfo that has been safely reencrypted
-func reencrypt(s pio.SiteInfo, newPass string) pio.SiteInfo {
+// reencrypt takes in a SiteInfo and will return a new SiteInfo that has been safely reencrypted.
+// The contents of file entries are written back to their encrypted file.
+func reencrypt(s pio.SiteInfo, newPass string) (pio.SiteInfo, error) {
+	if s.SSHPublicKey != "" {
+		return s, errors.New("SSH keys can not be edited, import a new key instead")
+	}
 	var c pio.ConfigFile
 	pub, priv, err := box.GenerateKey(rand.Reader)
 	if err != nil {
-		log.Fatalf("Could not generate new keys: %s", err.Error())
+		return s, fmt.Errorf("Could not generate new keys: %s", err.Error())
 	}
 	config, err := pio.GetConfigPath()
 	if err != nil {
-		log.Fatalf("Could not get config file name: %s", err.Error())
+		return s, fmt.Errorf("Could not get config file name: %s", err.Error())
 	}
 	configContents, err := ioutil.ReadFile(config)
 	if err != nil {
-		log.Fatalf("Could not read contents of config: %s", err.Error())
+		return s, fmt.Errorf("Could not read contents of config: %s", err.Error())
 	}
 	err = json.Unmarshal(configContents, &c)
 	if err != nil {
-		log.Fatalf("Could not unmarshal config file contents for reencrypt: %s", err.Error())
+		return s, fmt.Errorf("Could not unmarshal config file contents for reencrypt: %s", err.Error())
 	}
 	masterPub := c.MasterPubKey
 
+	if s.IsFile {
+		if err = pc.SealSite(&s, strings.NewReader(newPass), &masterPub, priv); err != nil {
+			return s, fmt.Errorf("Could not reencrypt file: %s", err.Error())
+		}
+		s.PubKey = *pub
+		s.Touch()
+		return s, nil
+	}
 	passSealed, err := pc.SealAsym([]byte(newPass), &masterPub, priv)
 	if err != nil {
-		log.Fatalf("Could not seal new site password: %s", err.Error())
-	}
-	return pio.SiteInfo{
-		PubKey:     *pub,
-		Name:       s.Name,
-		PassSealed: passSealed,
+		return s, fmt.Errorf("Could not seal new site password: %s", err.Error())
 	}
+	s.PubKey = *pub
+	s.PassSealed = passSealed
+	s.Touch()
+	return s, nil
 }
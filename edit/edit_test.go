@@ -0,0 +1,72 @@
+package edit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+func TestMoveGroupMovesFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "passgo-move")
+	if err != nil {
+		t.Fatalf("Could not create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv(pio.PASSGODIR, dir)
+	defer os.Unsetenv(pio.PASSGODIR)
+
+	encFileDir := filepath.Join(dir, pio.EncryptedFileDir)
+	files := map[string]string{"work/a": "a", "work/b/a": "b/a"}
+	for name, contents := range files {
+		p := filepath.Join(encFileDir, filepath.FromSlash(name))
+		if err = os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+			t.Fatalf("Could not create file dir: %s", err)
+		}
+		if err = ioutil.WriteFile(p, []byte(contents), 0600); err != nil {
+			t.Fatalf("Could not write file: %s", err)
+		}
+	}
+	err = pio.UpdateVault(pio.SiteFile{
+		{Name: "work/a", FileName: "work/a", IsFile: true},
+		{Name: "work/b/a", FileName: "work/b/a", IsFile: true},
+		{Name: "work/c"},
+	})
+	if err != nil {
+		t.Fatalf("Could not write vault: %s", err)
+	}
+
+	// Every site moves one group down, so work/a takes over the name of
+	// work/b/a while it moves to work/b/b/a.
+	if err = Move("work", "work/b"); err != nil {
+		t.Fatalf("Could not move group: %s", err)
+	}
+	want := map[string]string{"work/b/a": "a", "work/b/b/a": "b/a"}
+	for _, site := range pio.GetVault() {
+		if !site.IsFile {
+			continue
+		}
+		if site.FileName != site.Name {
+			t.Errorf("%s was left with the file name %s", site.Name, site.FileName)
+		}
+		contents, err := site.Sealed()
+		if err != nil {
+			t.Fatalf("Could not read %s: %s", site.Name, err)
+		}
+		if string(contents) != want[site.Name] {
+			t.Errorf("%s has contents %q, want %q", site.Name, contents, want[site.Name])
+		}
+	}
+	if _, err = os.Stat(filepath.Join(encFileDir, "work", "a")); !os.IsNotExist(err) {
+		t.Errorf("The old encrypted file was left behind: %v", err)
+	}
+	entries, err := ioutil.ReadDir(filepath.Join(encFileDir, "work"))
+	if err != nil {
+		t.Fatalf("Could not read encrypted file dir: %s", err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "b" {
+		t.Errorf("Unexpected entries left in the encrypted file dir: %v", entries)
+	}
+}
@@ -0,0 +1,152 @@
+package edit
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+const (
+	// EditorEnv is the environment variable naming the editor to use.
+	EditorEnv = "EDITOR"
+	// defaultEditor is used when EditorEnv is not set.
+	defaultEditor = "vi"
+	// shmDir is an in-memory filesystem available on most linux systems.
+	shmDir = "/dev/shm"
+)
+
+// Editor is used to change the contents of a site, or a file entry, in
+// the user's $EDITOR. The decrypted contents only ever live in a
+// temporary file that is readable by the user alone, on an in-memory
+// filesystem when one is available, and that is shredded afterwards.
+// New keys MUST be generated.
+func Editor(path string) {
+	vault := pio.GetVault()
+	for jj, siteInfo := range vault {
+		if siteInfo.Name != path {
+			continue
+		}
+		// The private key would otherwise be written to the temp file.
+		if siteInfo.SSHPublicKey != "" {
+			log.Fatalf("Could not edit %s: SSH keys can not be edited, import a new key instead", path)
+		}
+		masterPrivKey := pc.GetMasterKey()
+		r, err := pc.OpenSite(&siteInfo, &masterPrivKey)
+		if err != nil {
+			log.Fatalf("Could not decrypt %s: %s", path, err.Error())
+		}
+		contents, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			log.Fatalf("Could not decrypt %s: %s", path, err.Error())
+		}
+		edited, err := editInEditor(contents, filepath.Base(siteInfo.Name))
+		if err != nil {
+			log.Fatalf("Could not edit %s: %s", path, err.Error())
+		}
+		if !siteInfo.IsFile {
+			// Editors end the last line with a newline when saving, which
+			// is not part of a password.
+			contents = bytes.TrimSuffix(contents, []byte("\n"))
+			edited = bytes.TrimSuffix(edited, []byte("\n"))
+		}
+		if bytes.Equal(contents, edited) {
+			fmt.Printf("No changes made to %s\n", path)
+			return
+		}
+		newSiteInfo, err := reencrypt(siteInfo, string(edited))
+		if err != nil {
+			log.Fatalf("Could not edit %s: %s", path, err)
+		}
+		vault[jj] = newSiteInfo
+		err = pio.UpdateVault(vault)
+		if err != nil {
+			newSiteInfo.DiscardFile()
+			log.Fatalf("Could not edit %s: %s", path, err)
+		}
+		if err = newSiteInfo.CommitFile(); err != nil {
+			log.Fatalf("Could not save encrypted file for %s: %s", path, err)
+		}
+		return
+	}
+	log.Fatalf("Could not find %s in vault", path)
+}
+
+// secureTempDir returns the directory that decrypted contents should be
+// written to. An empty string means the default temporary directory.
+func secureTempDir() string {
+	if runtime.GOOS == "linux" {
+		if fi, err := os.Stat(shmDir); err == nil && fi.IsDir() {
+			return shmDir
+		}
+	}
+	log.Printf("Warning: no in-memory filesystem found. Decrypted contents will be written to %s", os.TempDir())
+	return ""
+}
+
+// editInEditor writes contents to a private temporary file named name,
+// opens it in the user's editor and returns the edited contents.
+func editInEditor(contents []byte, name string) (edited []byte, err error) {
+	dir, err := ioutil.TempDir(secureTempDir(), "passgo-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tmpPath := filepath.Join(dir, name)
+	f, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer shred(tmpPath)
+	_, err = f.Write(contents)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	editor := strings.Fields(os.Getenv(EditorEnv))
+	if len(editor) == 0 {
+		editor = []string{defaultEditor}
+	}
+	cmd := exec.Command(editor[0], append(editor[1:], tmpPath)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("Editor %s failed: %s", editor[0], err.Error())
+	}
+	return ioutil.ReadFile(tmpPath)
+}
+
+// shred overwrites the file at path with random data and then zeros
+// before removing it, so its contents do not linger on disk.
+func shred(path string) {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0600)
+	if err == nil {
+		if fi, err := f.Stat(); err == nil {
+			noise := make([]byte, fi.Size())
+			rand.Read(noise)
+			f.WriteAt(noise, 0)
+			f.Sync()
+			f.WriteAt(make([]byte, fi.Size()), 0)
+			f.Sync()
+		}
+		f.Close()
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Printf("Could not remove temporary file %s: %s", path, err.Error())
+	}
+}
@@ -0,0 +1,106 @@
+package edit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/internal/vaulttest"
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// TestHelperEditor is not a real test. It is run as a fake $EDITOR by
+// the tests below and appends $PASSGO_TEST_EDITOR_APPEND, or a line, to
+// the file it is given.
+func TestHelperEditor(t *testing.T) {
+	if os.Getenv("PASSGO_TEST_EDITOR") != "1" {
+		return
+	}
+	path := os.Args[len(os.Args)-1]
+	fi, err := os.Stat(path)
+	if err != nil || fi.Mode().Perm() != 0600 {
+		fmt.Fprintf(os.Stderr, "temporary file is not private: %v %v", fi, err)
+		os.Exit(1)
+	}
+	contents, _ := ioutil.ReadFile(path)
+	appended := os.Getenv("PASSGO_TEST_EDITOR_APPEND")
+	if appended == "" {
+		appended = "edited\n"
+	}
+	ioutil.WriteFile(path, append(contents, appended...), 0600)
+	ioutil.WriteFile(os.Getenv("PASSGO_TEST_EDITED_PATH"), []byte(path), 0600)
+	os.Exit(0)
+}
+
+// useHelperEditor makes TestHelperEditor the editor, and returns the file
+// it records the path of the temporary file in.
+func useHelperEditor(t *testing.T) (record string, cleanup func()) {
+	f, err := ioutil.TempFile("", "passgo-edited-path")
+	if err != nil {
+		t.Fatalf("Could not create temp file: %s", err)
+	}
+	f.Close()
+	os.Setenv("PASSGO_TEST_EDITOR", "1")
+	os.Setenv("PASSGO_TEST_EDITED_PATH", f.Name())
+	os.Setenv(EditorEnv, os.Args[0]+" -test.run=TestHelperEditor --")
+	return f.Name(), func() {
+		os.Unsetenv("PASSGO_TEST_EDITOR")
+		os.Unsetenv("PASSGO_TEST_EDITED_PATH")
+		os.Unsetenv(EditorEnv)
+		os.Remove(f.Name())
+	}
+}
+
+func TestEditInEditor(t *testing.T) {
+	record, cleanup := useHelperEditor(t)
+	defer cleanup()
+
+	edited, err := editInEditor([]byte("hunter2\n"), "bank.com")
+	if err != nil {
+		t.Fatalf("Could not edit in editor: %s", err)
+	}
+	if string(edited) != "hunter2\nedited\n" {
+		t.Errorf("editInEditor: expected edited contents, actual %q", edited)
+	}
+	tmpPath, _ := ioutil.ReadFile(record)
+	if len(tmpPath) == 0 {
+		t.Fatalf("Fake editor was not run")
+	}
+	if _, err := os.Stat(string(tmpPath)); !os.IsNotExist(err) {
+		t.Errorf("Temporary file %s was not removed", tmpPath)
+	}
+}
+
+func TestEditorTrailingNewline(t *testing.T) {
+	_, cleanup := useHelperEditor(t)
+	defer cleanup()
+	_, removeVault := vaulttest.New(t)
+	defer removeVault()
+	vaulttest.AddPassword(t, "money/bank.com", "hunter2", pio.Metadata{})
+	key := vaulttest.Unlock()
+	password := func() string {
+		site := pio.GetVault()[0]
+		pass, err := pc.OpenAsym(site.PassSealed, &site.PubKey, &key)
+		if err != nil {
+			t.Fatalf("Could not open site: %s", err)
+		}
+		return string(pass)
+	}
+	defer os.Unsetenv("PASSGO_TEST_EDITOR_APPEND")
+
+	// Editors add a newline at the end when saving, which is not kept.
+	for _, tc := range []struct{ appended, want string }{
+		{"\n", "hunter2"},
+		{"3\n", "hunter23"},
+	} {
+		os.Setenv("PASSGO_TEST_EDITOR_APPEND", tc.appended)
+		pio.Input = pio.NewStreamPrompter(strings.NewReader(vaulttest.MasterPassword+"\n"), ioutil.Discard)
+		Editor("money/bank.com")
+		if got := password(); got != tc.want {
+			t.Errorf("Appending %q stored %q, want %q", tc.appended, got, tc.want)
+		}
+	}
+}
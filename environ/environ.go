@@ -0,0 +1,116 @@
+// Package environ runs commands with passwords from the vault in their
+// environment, so they never have to be exported in a shell.
+package environ
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// Var is an environment variable set to the contents of a site.
+type Var struct {
+	Name string
+	Site string
+}
+
+var varName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ParseVars parses specs of the form NAME=site.
+func ParseVars(specs []string) ([]Var, error) {
+	var vars []Var
+	seen := map[string]bool{}
+	for _, spec := range specs {
+		i := strings.Index(spec, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("%q must be NAME=site", spec)
+		}
+		v := Var{Name: spec[:i], Site: pio.CleanPath(spec[i+1:])}
+		if !varName.MatchString(v.Name) {
+			return nil, fmt.Errorf("%q is not a valid variable name", v.Name)
+		}
+		if v.Site == "" {
+			return nil, fmt.Errorf("No site given for %s", v.Name)
+		}
+		if seen[v.Name] {
+			return nil, fmt.Errorf("%s is set more than once", v.Name)
+		}
+		seen[v.Name] = true
+		vars = append(vars, v)
+	}
+	return vars, nil
+}
+
+// Sites returns the sites vars refer to, or an error naming the first one
+// that is not in the vault.
+func Sites(vars []Var) (map[string]pio.SiteInfo, error) {
+	vault := map[string]pio.SiteInfo{}
+	for _, site := range pio.GetVault() {
+		vault[site.Name] = site
+	}
+	sites := map[string]pio.SiteInfo{}
+	for _, v := range vars {
+		site, ok := vault[v.Site]
+		if !ok {
+			return nil, fmt.Errorf("Site %s not found", v.Site)
+		}
+		sites[v.Site] = site
+	}
+	return sites, nil
+}
+
+// Environ returns base with vars set to the decrypted contents of their
+// sites. Like $(passgo show site), trailing newlines are removed.
+func Environ(base []string, vars []Var, sites map[string]pio.SiteInfo, masterPrivKey [32]byte) ([]string, error) {
+	env := []string{}
+	set := map[string]bool{}
+	for _, v := range vars {
+		set[v.Name] = true
+	}
+	for _, kv := range base {
+		if i := strings.Index(kv, "="); i < 0 || !set[kv[:i]] {
+			env = append(env, kv)
+		}
+	}
+	for _, v := range vars {
+		site := sites[v.Site]
+		r, err := pc.OpenSite(&site, &masterPrivKey)
+		if err != nil {
+			return nil, fmt.Errorf("Could not decrypt %s: %s", v.Site, err.Error())
+		}
+		contents, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("Could not decrypt %s: %s", v.Site, err.Error())
+		}
+		if bytes.IndexByte(contents, 0) >= 0 {
+			return nil, fmt.Errorf("%s contains NUL bytes and can not be put in the environment", v.Site)
+		}
+		env = append(env, v.Name+"="+strings.TrimRight(string(contents), "\n"))
+	}
+	return env, nil
+}
+
+// Exec unlocks the vault once and runs args with vars set in its
+// environment. It only returns if the command could not be started.
+func Exec(vars []Var, args []string, base []string) error {
+	sites, err := Sites(vars)
+	if err != nil {
+		return err
+	}
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return err
+	}
+	env, err := Environ(base, vars, sites, pc.GetMasterKey())
+	if err != nil {
+		return err
+	}
+	return run(path, args, env)
+}
@@ -0,0 +1,55 @@
+package environ
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/internal/vaulttest"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+func TestParseVars(t *testing.T) {
+	vars, err := ParseVars([]string{"AWS_SECRET=work/aws/secret", "DB_PASS=/db/prod/"})
+	want := []Var{{"AWS_SECRET", "work/aws/secret"}, {"DB_PASS", "db/prod"}}
+	if err != nil || !reflect.DeepEqual(vars, want) {
+		t.Fatalf("ParseVars returned %v, %v", vars, err)
+	}
+	for _, bad := range [][]string{
+		{"AWS_SECRET"},
+		{"1PASS=db/prod"},
+		{"MY-PASS=db/prod"},
+		{"=db/prod"},
+		{"DB_PASS="},
+		{"DB_PASS=db/prod", "DB_PASS=db/test"},
+	} {
+		if _, err := ParseVars(bad); err == nil {
+			t.Errorf("ParseVars(%q) did not fail", bad)
+		}
+	}
+}
+
+func TestEnviron(t *testing.T) {
+	_, cleanup := vaulttest.New(t)
+	defer cleanup()
+	vaulttest.AddPassword(t, "work/aws/secret", "AKIA/secret", pio.Metadata{})
+	vaulttest.AddPassword(t, "db/prod", "hunter2\nnotes\n\n", pio.Metadata{})
+	key := vaulttest.Unlock()
+
+	vars, _ := ParseVars([]string{"AWS_SECRET=work/aws/secret", "DB_PASS=db/prod"})
+	if _, err := Sites(append(vars, Var{"MISSING", "db/missing"})); err == nil || !strings.Contains(err.Error(), "db/missing") {
+		t.Fatalf("Missing site returned %v", err)
+	}
+	sites, err := Sites(vars)
+	if err != nil {
+		t.Fatalf("Sites returned %s", err)
+	}
+	env, err := Environ([]string{"HOME=/home/alice", "DB_PASS=old"}, vars, sites, key)
+	if err != nil {
+		t.Fatalf("Environ returned %s", err)
+	}
+	want := []string{"HOME=/home/alice", "AWS_SECRET=AKIA/secret", "DB_PASS=hunter2\nnotes"}
+	if !reflect.DeepEqual(env, want) {
+		t.Errorf("Environ returned %q, expected %q", env, want)
+	}
+}
@@ -0,0 +1,12 @@
+//go:build !windows
+// +build !windows
+
+package environ
+
+import "syscall"
+
+// run replaces passgo with the command, so its exit status and signals
+// are the command's own.
+func run(path string, args, env []string) error {
+	return syscall.Exec(path, args, env)
+}
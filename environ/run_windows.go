@@ -0,0 +1,31 @@
+package environ
+
+import (
+	"os"
+	"os/exec"
+	"os/signal"
+)
+
+// run starts the command and exits with its status once it is done, since
+// Windows can not replace the running process.
+func run(path string, args, env []string) error {
+	cmd := exec.Command(path, args[1:]...)
+	cmd.Env = env
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	// The console sends Ctrl-C to the command as well, so passgo only
+	// has to wait for it to exit.
+	signal.Ignore(os.Interrupt)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	if err := cmd.Wait(); err != nil {
+		if exit, ok := err.(*exec.ExitError); ok {
+			os.Exit(exit.ExitCode())
+		}
+		return err
+	}
+	os.Exit(0)
+	return nil
+}
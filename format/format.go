@@ -0,0 +1,52 @@
+// Package format prints the results of passgo commands as JSON or YAML
+// for scripts, using the records defined in this package as a stable
+// schema.
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Format is the way command results are printed.
+type Format int
+
+const (
+	// Plain is the output meant for people, such as the tree of sites.
+	Plain Format = iota
+	// JSON prints results as indented JSON.
+	JSON
+	// YAML prints results as YAML.
+	YAML
+)
+
+var formats = map[string]Format{
+	"plain": Plain,
+	"json":  JSON,
+	"yaml":  YAML,
+}
+
+// Parse returns the Format called name, which is one of plain, json or
+// yaml.
+func Parse(name string) (Format, error) {
+	f, ok := formats[name]
+	if !ok {
+		return Plain, fmt.Errorf("Unknown format %q, must be plain, json or yaml", name)
+	}
+	return f, nil
+}
+
+// Write encodes v to w as JSON or YAML. Plain output is up to the caller,
+// so v is written as JSON for Plain as well.
+func (f Format) Write(w io.Writer, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if f == YAML {
+		return jsonToYAML(w, b)
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
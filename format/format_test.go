@@ -0,0 +1,69 @@
+package format
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestYAML(t *testing.T) {
+	created := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	sites := []Site{
+		{Name: "money/bank.com", Group: "money", Type: TypePassword, Created: &created},
+		{Name: "budget.csv", Type: TypeFile, File: &File{Name: "budget.csv", Mode: "0600", Size: 10, StoredSize: 50}},
+	}
+	expected := `- name: "money/bank.com"
+  group: "money"
+  type: "password"
+  created: "2019-03-01T12:00:00Z"
+- name: "budget.csv"
+  group: ""
+  type: "file"
+  file:
+    name: "budget.csv"
+    mode: "0600"
+    size: 10
+    stored_size: 50
+`
+	var out bytes.Buffer
+	if err := YAML.Write(&out, sites); err != nil {
+		t.Fatalf("Could not write YAML: %s", err)
+	}
+	if out.String() != expected {
+		t.Errorf("YAML output:\n%s\nexpected:\n%s", out.String(), expected)
+	}
+}
+
+func TestYAMLScalars(t *testing.T) {
+	tests := []struct {
+		v        interface{}
+		expected string
+	}{
+		{[]string{}, "[]\n"},
+		{map[string]string{}, "{}\n"},
+		{"yes", "\"yes\"\n"},
+		{"a: b\n#c", "\"a: b\\n#c\"\n"},
+		{[][]int{{1, 2}, {}}, "- - 1\n  - 2\n- []\n"},
+		{map[string]interface{}{"a": nil, "b": true}, "a: null\nb: true\n"},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		if err := YAML.Write(&out, tt.v); err != nil {
+			t.Fatalf("Could not write %v: %s", tt.v, err)
+		}
+		if out.String() != tt.expected {
+			t.Errorf("%#v: expected %q, actual %q", tt.v, tt.expected, out.String())
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	for name, expected := range formats {
+		if f, err := Parse(name); err != nil || f != expected {
+			t.Errorf("Parse(%q) = %v, %v", name, f, err)
+		}
+	}
+	if _, err := Parse("xml"); err == nil {
+		t.Errorf("Parse accepted an unknown format")
+	}
+}
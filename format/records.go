@@ -0,0 +1,145 @@
+package format
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ejcx/passgo/v2/pio"
+	"github.com/ejcx/passgo/v2/stats"
+)
+
+// The records below are what passgo prints with --format json or yaml.
+// Fields may be added to them, but are never renamed or removed.
+
+const (
+	// TypePassword is the type of password entries.
+	TypePassword = "password"
+	// TypeFile is the type of file entries.
+	TypeFile = "file"
+	// TypeSSHKey is the type of SSH key entries.
+	TypeSSHKey = "ssh-key"
+)
+
+// Site describes a site in the vault.
+type Site struct {
+	// Name is the full path of the site, including its group.
+	Name string `json:"name"`
+	// Group is the group the site is in, or "" at the top of the vault.
+	Group string `json:"group"`
+	// Type is "password", "file" or "ssh-key".
+	Type string `json:"type"`
+	// Created and Modified are left out for sites that were added by
+	// versions of passgo that did not record them.
+	Created  *time.Time `json:"created,omitempty"`
+	Modified *time.Time `json:"modified,omitempty"`
+	// URL, Username and Tags are left out when the site does not have
+	// them.
+	URL      string   `json:"url,omitempty"`
+	Username string   `json:"username,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	// File is only set for file entries.
+	File *File `json:"file,omitempty"`
+	// SSHPublicKey is only set for SSH key entries, in authorized_keys
+	// format.
+	SSHPublicKey string `json:"ssh_public_key,omitempty"`
+}
+
+// File describes the file stored in a file entry.
+type File struct {
+	// Name is the name of the file that was inserted.
+	Name string `json:"name"`
+	// Mode is the octal permissions the file is extracted with.
+	Mode string `json:"mode"`
+	// Size is the size of the file, and StoredSize the size it takes up
+	// in the vault once compressed and encrypted.
+	Size       int64 `json:"size"`
+	StoredSize int64 `json:"stored_size"`
+	// Compression is the algorithm the file was compressed with, if any.
+	Compression string `json:"compression,omitempty"`
+}
+
+// Secret is a site along with its decrypted password or file contents.
+type Secret struct {
+	Site
+	// Secret is the password or file contents. Binary file contents are
+	// base64 encoded, in which case Encoding is "base64".
+	Secret   string `json:"secret"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// AuditResult is the estimated strength of a password.
+type AuditResult struct {
+	Name string `json:"name"`
+	// Score is from 0, too guessable, to 4, very unguessable, and
+	// Strength is its description.
+	Score    int    `json:"score"`
+	Strength string `json:"strength"`
+	// Guesses is the estimated number of guesses needed to crack the
+	// password, and CrackTime how long that takes offline in seconds.
+	Guesses   float64 `json:"guesses"`
+	CrackTime float64 `json:"crack_time"`
+	Weak      bool    `json:"weak"`
+	Warning   string  `json:"warning,omitempty"`
+}
+
+// NewSite returns the record of a site from the vault. The site's name
+// must be its full path.
+func NewSite(site pio.SiteInfo) Site {
+	s := Site{
+		Name:     site.Name,
+		Group:    path.Dir(site.Name),
+		Type:     TypePassword,
+		Created:  site.Created,
+		Modified: site.Modified,
+		URL:      site.URL,
+		Username: site.Username,
+		Tags:     site.Tags,
+	}
+	if s.Group == "." {
+		s.Group = ""
+	}
+	if site.SSHPublicKey != "" {
+		s.Type = TypeSSHKey
+		s.SSHPublicKey = site.SSHPublicKey
+	}
+	if !site.IsFile {
+		return s
+	}
+	s.Type = TypeFile
+	mode := site.Mode
+	if mode == 0 {
+		mode = 0600
+	}
+	s.File = &File{
+		Name:        site.OrigName,
+		Mode:        fmt.Sprintf("%04o", mode),
+		Compression: site.Compression,
+	}
+	if s.File.Name == "" {
+		s.File.Name = path.Base(site.Name)
+	}
+	if encFileDir, err := pio.GetEncryptedFilesDir(); err == nil {
+		if fi, err := os.Stat(filepath.Join(encFileDir, site.FileName)); err == nil {
+			s.File.StoredSize = fi.Size()
+			s.File.Size = stats.Original(&site, fi.Size())
+		}
+	}
+	return s
+}
+
+// NewSecret returns the record of a site and its decrypted contents.
+func NewSecret(site pio.SiteInfo, contents []byte) Secret {
+	s := Secret{Site: NewSite(site)}
+	if utf8.Valid(contents) {
+		s.Secret = string(contents)
+	} else {
+		s.Secret = base64.StdEncoding.EncodeToString(contents)
+		s.Encoding = "base64"
+	}
+	return s
+}
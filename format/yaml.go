@@ -0,0 +1,142 @@
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// object is a JSON object that keeps the order of its keys.
+type object []member
+
+type member struct {
+	key   string
+	value interface{}
+}
+
+// jsonToYAML writes the JSON document b to w as YAML. Object keys keep
+// their order, so YAML output follows the field order of the records.
+// Strings are always double quoted, which YAML reads the same as JSON.
+func jsonToYAML(w io.Writer, b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	v, err := decodeOrdered(dec)
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	writeYAML(&out, v, 0)
+	_, err = w.Write(out.Bytes())
+	return err
+}
+
+func decodeOrdered(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		o := object{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			o = append(o, member{key.(string), value})
+		}
+		_, err = dec.Token()
+		return o, err
+	case json.Delim('['):
+		a := []interface{}{}
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, value)
+		}
+		_, err = dec.Token()
+		return a, err
+	}
+	return tok, nil
+}
+
+func writeYAML(w *bytes.Buffer, v interface{}, indent int) {
+	pad := strings.Repeat(" ", indent)
+	switch v := v.(type) {
+	case object:
+		if len(v) == 0 {
+			fmt.Fprintf(w, "%s{}\n", pad)
+			return
+		}
+		for _, m := range v {
+			if isBlock(m.value) {
+				fmt.Fprintf(w, "%s%s:\n", pad, m.key)
+				writeYAML(w, m.value, indent+2)
+			} else {
+				fmt.Fprintf(w, "%s%s: %s\n", pad, m.key, scalar(m.value))
+			}
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			fmt.Fprintf(w, "%s[]\n", pad)
+			return
+		}
+		for _, item := range v {
+			if !isBlock(item) {
+				fmt.Fprintf(w, "%s- %s\n", pad, scalar(item))
+				continue
+			}
+			// Write the item indented, then put the dash in front of
+			// its first line.
+			var item2 bytes.Buffer
+			writeYAML(&item2, item, indent+2)
+			block := item2.Bytes()
+			w.WriteString(pad)
+			w.WriteString("- ")
+			w.Write(block[indent+2:])
+		}
+	default:
+		fmt.Fprintf(w, "%s%s\n", pad, scalar(v))
+	}
+}
+
+// isBlock reports whether v is a non-empty object or array.
+func isBlock(v interface{}) bool {
+	switch v := v.(type) {
+	case object:
+		return len(v) > 0
+	case []interface{}:
+		return len(v) > 0
+	}
+	return false
+}
+
+func scalar(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return fmt.Sprint(v)
+	case json.Number:
+		return v.String()
+	case string:
+		var b bytes.Buffer
+		enc := json.NewEncoder(&b)
+		enc.SetEscapeHTML(false)
+		enc.Encode(v)
+		return strings.TrimSuffix(b.String(), "\n")
+	case object:
+		return "{}"
+	case []interface{}:
+		return "[]"
+	}
+	return fmt.Sprint(v)
+}
@@ -1,7 +1,11 @@
 package generate
 
 import (
+	"bufio"
+	"io"
 	"log"
+	"os"
+	"strings"
 
 	"github.com/ejcx/passgo/v2/pc"
 )
@@ -14,21 +18,113 @@ var (
 // A default password length of 24 will be used if
 //     1. No pwlen is supplied
 //     2. pwlen is less than 1
-//     3. pwlen is greater than MaxPwLength
+// A pwlen greater than pc.MaxPwLength is fatal.
 func Generate(pwlen int) string {
-	if pwlen < 1 {
-		pwlen = defaultPwLen
-	}
-	// By default, we should generate a strong password that needs everything
-	specs := &pc.PasswordSpecs{
+	return WithSpecs(DefaultSpecs(), pwlen)
+}
+
+// DefaultSpecs returns the specs used for generated passwords. By default,
+// we should generate a strong password that needs everything.
+func DefaultSpecs() *pc.PasswordSpecs {
+	return &pc.PasswordSpecs{
 		NeedsUpper:  true,
 		NeedsLower:  true,
 		NeedsSymbol: true,
 		NeedsDigit:  true,
 	}
+}
+
+// WithSpecs will return a securely generated password that meets specs.
+// Character classes that have been left out of the alphabet of specs, by
+// using a charset or exclusions, are no longer needed.
+func WithSpecs(specs *pc.PasswordSpecs, pwlen int) string {
+	if pwlen < 1 {
+		pwlen = defaultPwLen
+	}
+	upper, lower, symbol, digit := specs.HasClasses()
+	specs.NeedsUpper = specs.NeedsUpper && upper
+	specs.NeedsLower = specs.NeedsLower && lower
+	specs.NeedsSymbol = specs.NeedsSymbol && symbol
+	specs.NeedsDigit = specs.NeedsDigit && digit
 	pass, err := pc.GeneratePassword(specs, pwlen)
 	if err != nil {
 		log.Fatalf("Could not generate password: %s", err.Error())
 	}
 	return pass
 }
+
+// Policy will return a securely generated password that follows the saved
+// password policy called name.
+func Policy(name string, pwlen int) string {
+	policy, err := pc.GetPolicy(name)
+	if err != nil {
+		log.Fatalf("Could not get password policy: %s", err.Error())
+	}
+	pwlen, err = policy.Length(pwlen, defaultPwLen)
+	if err != nil {
+		log.Fatalf("Could not generate password: %s", err.Error())
+	}
+	return WithSpecs(&policy.PasswordSpecs, pwlen)
+}
+
+// SavePolicy saves specs as the password policy called name. If pwlen is
+// set it becomes the maximum length allowed by the policy.
+func SavePolicy(name string, specs *pc.PasswordSpecs, pwlen int) {
+	policy := pc.Policy{
+		PasswordSpecs: *specs,
+	}
+	if pwlen > 0 {
+		policy.MaxLength = pwlen
+	}
+	if err := pc.SavePolicy(name, policy); err != nil {
+		log.Fatalf("Could not save password policy: %s", err.Error())
+	}
+}
+
+// Passphrase will return a securely generated passphrase along with its
+// entropy in bits. If wordlistPath is not empty the words are read from
+// that file instead of the built in EFF long wordlist.
+func Passphrase(specs *pc.PassphraseSpecs, wordlistPath string) (string, float64) {
+	if specs.Words < 1 {
+		specs.Words = pc.DefaultPassphraseWords
+	}
+	if wordlistPath != "" {
+		f, err := os.Open(wordlistPath)
+		if err != nil {
+			log.Fatalf("Could not open wordlist: %s", err.Error())
+		}
+		defer f.Close()
+		specs.Wordlist, err = ReadWordlist(f)
+		if err != nil {
+			log.Fatalf("Could not read wordlist: %s", err.Error())
+		}
+	}
+	pass, bits, err := pc.GeneratePassphrase(specs)
+	if err != nil {
+		log.Fatalf("Could not generate passphrase: %s", err.Error())
+	}
+	return pass, bits
+}
+
+// ReadWordlist reads a wordlist with one word per line. Diceware style
+// lists, where each word is preceded by its dice roll, are also accepted.
+// Blank lines and duplicate words are ignored so that they do not skew
+// the selection of words.
+func ReadWordlist(r io.Reader) (words []string, err error) {
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		word := fields[len(fields)-1]
+		if seen[word] {
+			continue
+		}
+		seen[word] = true
+		words = append(words, word)
+	}
+	err = scanner.Err()
+	return
+}
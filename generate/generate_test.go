@@ -1,6 +1,7 @@
 package generate
 
 import (
+	"strings"
 	"testing"
 	"unicode/utf8"
 )
@@ -27,3 +28,20 @@ func TestGenerate(t *testing.T) {
 		}
 	}
 }
+
+func TestReadWordlist(t *testing.T) {
+	list := "11111\tabacus\n11112\tabdomen\n\nabide\nabide\n"
+	words, err := ReadWordlist(strings.NewReader(list))
+	if err != nil {
+		t.Fatalf("Could not read wordlist: %s", err)
+	}
+	expected := []string{"abacus", "abdomen", "abide"}
+	if len(words) != len(expected) {
+		t.Fatalf("ReadWordlist: expected %v, actual %v", expected, words)
+	}
+	for i := range expected {
+		if words[i] != expected[i] {
+			t.Errorf("ReadWordlist: expected %v, actual %v", expected, words)
+		}
+	}
+}
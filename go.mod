@@ -3,10 +3,12 @@ module github.com/ejcx/passgo/v2
 go 1.12
 
 require (
+	bazil.org/fuse v0.0.0-20200117225306-7b5117fecadc
 	github.com/atotto/clipboard v0.1.1
+	github.com/gdamore/tcell/v2 v2.4.0
 	github.com/inconshreveable/mousetrap v1.0.0 // indirect
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	github.com/spf13/cobra v0.0.3
-	github.com/spf13/pflag v1.0.3 // indirect
+	github.com/spf13/pflag v1.0.3
 	golang.org/x/crypto v0.0.0-20190222235706-ffb98f73852f
-	golang.org/x/sys v0.0.0-20190222171317-cd391775e71e // indirect
 )
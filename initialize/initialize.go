@@ -18,6 +18,13 @@ const (
 
 // Init will initialize a new password vault in the home directory.
 func Init() {
+	InitWithKeyfile("")
+}
+
+// InitWithKeyfile initializes a new password vault that needs the keyfile
+// at keyfile, as well as the master password, to unlock. The keyfile is
+// created if it does not exist. No keyfile is used if it is empty.
+func InitWithKeyfile(keyfile string) {
 	var needsDir bool
 	var hasConfig bool
 	var hasVault bool
@@ -52,7 +59,7 @@ func Init() {
 	// because if the user quits before the vault is fully initialized
 	// (probably during password prompt since it's blocking), they will
 	// be able to run init again a second time.
-	pass, err := pio.PromptPass("Please enter a strong master password")
+	pass, err := pio.PromptMasterPass("Please enter a strong master password")
 	if err != nil {
 		log.Fatalf("Could not read password: %s", err.Error())
 	}
@@ -85,6 +92,12 @@ func Init() {
 		log.Fatalf(configFound)
 	}
 
+	if keyfile != "" {
+		if keyfile, err = pc.CreateKeyfile(keyfile); err != nil {
+			log.Fatalf("Could not create keyfile: %s", err.Error())
+		}
+	}
+
 	// Create file with secure permission.  os.Create() leaves file world-readable.
 	config, err := os.OpenFile(configFile, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
 	if err != nil {
@@ -108,13 +121,6 @@ func Init() {
 		sf.Close()
 	}
 
-	// Generate a master password salt.
-	var keySalt [32]byte
-	_, err = rand.Read(keySalt[:])
-	if err != nil {
-		log.Fatalf("Could not generate random salt: %s", err.Error())
-	}
-
 	// Create a new salt for encrypting public key.
 	var hmacSalt [32]byte
 	_, err = rand.Read(hmacSalt[:])
@@ -122,27 +128,19 @@ func Init() {
 		log.Fatalf("Could not generate random salt: %s", err.Error())
 	}
 
-	// kdf the master password.
-	passKey, err := pc.Scrypt([]byte(pass), keySalt[:])
-	if err != nil {
-		log.Fatalf("Could not generate master key from pass: %s", err.Error())
-	}
-
 	pub, priv, err := box.GenerateKey(rand.Reader)
 	if err != nil {
 		log.Fatalf("Could not generate master key pair: %s", err.Error())
 	}
 
-	// Encrypt master private key with master password key.
-	sealedMasterPrivKey, err := pc.Seal(&passKey, priv[:])
-	if err != nil {
-		log.Fatalf("Could not encrypt master key: %s", err.Error())
-	}
-
 	passConfig := pio.ConfigFile{
-		MasterKeyPrivSealed: sealedMasterPrivKey,
-		MasterPubKey:        *pub,
-		MasterPassKeySalt:   keySalt,
+		MasterPubKey: *pub,
+		Keyfile:      keyfile,
+	}
+	// Encrypt master private key with the master password and keyfile,
+	// under a new salt.
+	if err = pc.SealMasterKey(pass, priv, &passConfig); err != nil {
+		log.Fatalf("Could not encrypt master key: %s", err.Error())
 	}
 
 	if err = passConfig.SaveFile(); err != nil {
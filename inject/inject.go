@@ -0,0 +1,221 @@
+// Package inject renders templates that refer to sites in the vault, such
+// as config files that need a database password.
+package inject
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// Fields are what a reference can ask for. The password is the first line
+// of the site, and contents all of it.
+var Fields = []string{"password", "contents", "username", "url"}
+
+// Template is a parsed template. References look like
+//
+//	{{ passgo "db/prod" "password" }}
+//
+// and the field may be left out, in which case it is "password".
+type Template struct {
+	t     *template.Template
+	vault map[string]pio.SiteInfo
+}
+
+// Parse parses a template called name.
+func Parse(name, text string) (*Template, error) {
+	t, err := template.New(name).Funcs(template.FuncMap{
+		"passgo": func(site string, field ...string) (string, error) { return "", nil },
+	}).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	vault := map[string]pio.SiteInfo{}
+	for _, site := range pio.GetVault() {
+		vault[site.Name] = site
+	}
+	return &Template{t: t, vault: vault}, nil
+}
+
+// lookup returns the site and field a reference is for.
+func (t *Template) lookup(name string, field []string) (site pio.SiteInfo, f string, found bool, err error) {
+	f = "password"
+	if len(field) > 1 {
+		return site, f, false, fmt.Errorf("passgo takes a site and at most one field")
+	}
+	if len(field) == 1 {
+		f = field[0]
+	}
+	known := false
+	for _, k := range Fields {
+		known = known || k == f
+	}
+	if !known {
+		return site, f, false, fmt.Errorf("Unknown field %q, must be one of %s", f, strings.Join(Fields, ", "))
+	}
+	site, found = t.vault[pio.CleanPath(name)]
+	if found && site.IsFile && f == "password" {
+		return site, f, found, fmt.Errorf("%s is a file entry, use \"contents\"", site.Name)
+	}
+	return site, f, found, nil
+}
+
+// Check returns the sites the template refers to without unlocking the
+// vault. It returns an error naming every site that does not exist.
+func (t *Template) Check() ([]string, error) {
+	refs := map[string]bool{}
+	missing := map[string]bool{}
+	t.t.Funcs(template.FuncMap{
+		"passgo": func(name string, field ...string) (string, error) {
+			_, _, found, err := t.lookup(name, field)
+			if err != nil {
+				return "", err
+			}
+			if found {
+				refs[pio.CleanPath(name)] = true
+			} else {
+				missing[name] = true
+			}
+			return "", nil
+		},
+	})
+	if err := t.t.Execute(ioutil.Discard, nil); err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("Sites not found: %s", strings.Join(sortedKeys(missing), ", "))
+	}
+	return sortedKeys(refs), nil
+}
+
+// Render writes the template to w with every reference replaced by the
+// decrypted value.
+func (t *Template) Render(w io.Writer, masterPrivKey [32]byte) error {
+	decrypted := map[string]string{}
+	t.t.Funcs(template.FuncMap{
+		"passgo": func(name string, field ...string) (string, error) {
+			site, f, found, err := t.lookup(name, field)
+			if err != nil {
+				return "", err
+			}
+			if !found {
+				return "", fmt.Errorf("Site %s not found", name)
+			}
+			switch f {
+			case "username":
+				return site.Username, nil
+			case "url":
+				return site.URL, nil
+			}
+			contents, ok := decrypted[site.Name]
+			if !ok {
+				if contents, err = decrypt(site, masterPrivKey); err != nil {
+					return "", err
+				}
+				decrypted[site.Name] = contents
+			}
+			if f == "password" {
+				return strings.SplitN(contents, "\n", 2)[0], nil
+			}
+			return contents, nil
+		},
+	})
+	return t.t.Execute(w, nil)
+}
+
+func decrypt(site pio.SiteInfo, masterPrivKey [32]byte) (string, error) {
+	r, err := pc.OpenSite(&site, &masterPrivKey)
+	if err != nil {
+		return "", fmt.Errorf("Could not decrypt %s: %s", site.Name, err.Error())
+	}
+	defer r.Close()
+	contents, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("Could not decrypt %s: %s", site.Name, err.Error())
+	}
+	return string(contents), nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Inject renders the template in the file in, or stdin if in is empty, to
+// the file out, or stdout if out is empty. out is only readable by the
+// current user, and is left alone if rendering fails. With check set,
+// the references are only checked and the vault is not unlocked.
+func Inject(in, out string, check bool) error {
+	var text []byte
+	var err error
+	name := in
+	if in == "" {
+		name = "stdin"
+		text, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		text, err = ioutil.ReadFile(in)
+	}
+	if err != nil {
+		return err
+	}
+	t, err := Parse(filepath.Base(name), string(text))
+	if err != nil {
+		return err
+	}
+	sites, err := t.Check()
+	if err != nil {
+		return err
+	}
+	if check {
+		fmt.Fprintf(os.Stderr, "All %d sites referenced by %s exist\n", len(sites), name)
+		return nil
+	}
+	var masterPrivKey [32]byte
+	if len(sites) > 0 {
+		masterPrivKey = pc.GetMasterKey()
+	}
+	var b bytes.Buffer
+	if err = t.Render(&b, masterPrivKey); err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(b.Bytes())
+		return err
+	}
+	return writeFile(out, b.Bytes())
+}
+
+// writeFile replaces path with a file holding b that only the current
+// user can read.
+func writeFile(path string, b []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if err = f.Chmod(0600); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
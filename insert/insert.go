@@ -4,9 +4,13 @@ package insert
 import (
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/ejcx/passgo/v2/pc"
 	"github.com/ejcx/passgo/v2/pio"
@@ -20,98 +24,243 @@ const (
 )
 
 // Password is used to add a new password entry to the vault.
-func Password(name string) {
-	var c pio.ConfigFile
-	pub, priv, err := box.GenerateKey(rand.Reader)
+func Password(name string, meta pio.Metadata) {
+	sitePass, err := pio.PromptSecret(fmt.Sprintf(PassPrompt, name))
 	if err != nil {
-		log.Fatalf("Could not generate site key: %s", err.Error())
+		log.Fatalf("Could not get password for site: %s", err.Error())
 	}
+	pc.PrintStrength(sitePass, name)
+	if err = SealPassword(name, sitePass, meta); err != nil {
+		log.Fatalf("Could not add %s: %s", name, err.Error())
+	}
+}
 
-	config, err := pio.GetConfigPath()
+// Multiline is used to add a new entry that spans several lines, such as
+// a password followed by notes, to the vault.
+func Multiline(name string, meta pio.Metadata) {
+	contents, err := pio.PromptMultiline(fmt.Sprintf("Enter contents of %s", name))
 	if err != nil {
-		log.Fatalf("Could not get config file name: %s", err.Error())
+		log.Fatalf("Could not get contents for site: %s", err.Error())
 	}
+	if err = SealPassword(name, contents, meta); err != nil {
+		log.Fatalf("Could not add %s: %s", name, err.Error())
+	}
+}
 
-	// Read the master public key.
-	configContents, err := ioutil.ReadFile(config)
+// SealPassword is used to add a new password entry to the vault without
+// prompting for the password.
+func SealPassword(name, sitePass string, meta pio.Metadata) error {
+	return sealSite(pio.SiteInfo{Name: name, Metadata: meta}, []byte(sitePass))
+}
+
+// SealSSHKey is used to add a new SSH key entry to the vault. privateKey
+// is the PEM encoded private key, and publicKey the public key in
+// authorized_keys format.
+func SealSSHKey(name string, privateKey []byte, publicKey string, meta pio.Metadata) error {
+	return sealSite(pio.SiteInfo{Name: name, SSHPublicKey: publicKey, Metadata: meta}, privateKey)
+}
+
+// sealSite seals secret with a new site key and adds si to the vault.
+func sealSite(si pio.SiteInfo, secret []byte) error {
+	name := si.Name
+	pub, priv, err := box.GenerateKey(rand.Reader)
 	if err != nil {
-		log.Fatalf("Could not get config file contents: %s", err.Error())
+		return fmt.Errorf("Could not generate site key: %s", err.Error())
 	}
 
-	err = json.Unmarshal(configContents, &c)
+	masterPub, err := masterPubKey()
 	if err != nil {
-		log.Fatalf("Could not unmarshal config file contents: %s", err.Error())
+		return fmt.Errorf("Could not read master public key: %s", err.Error())
 	}
 
-	masterPub := c.MasterPubKey
+	for _, s := range pio.GetVault() {
+		if s.Name == name {
+			return errors.New("A site with that name already exists")
+		}
+	}
 
-	sitePass, err := pio.PromptPass(fmt.Sprintf("Enter password for %s", name))
+	passSealed, err := pc.SealAsym(secret, &masterPub, priv)
 	if err != nil {
-		log.Fatalf("Could not get password for site: %s", err.Error())
+		return fmt.Errorf("Could not seal new site password: %s", err.Error())
 	}
 
-	passSealed, err := pc.SealAsym([]byte(sitePass), &masterPub, priv)
+	si.PubKey = *pub
+	si.PassSealed = passSealed
+	si.Touch()
+
+	err = si.AddSite()
 	if err != nil {
-		log.Fatalf("Could not seal new site password: %s", err.Error())
+		return fmt.Errorf("Could not save site: %s", err.Error())
 	}
+	return nil
+}
 
-	si := pio.SiteInfo{
-		PubKey:     *pub,
-		Name:       name,
-		PassSealed: passSealed,
+// File is used to add a new file entry to the vault. If compression is
+// set the file is compressed with that algorithm before it is encrypted.
+func File(path, filename, compression string, meta pio.Metadata) {
+	masterPub, err := masterPubKey()
+	if err != nil {
+		log.Fatalf("Could not read master public key: %s", err.Error())
 	}
-
-	err = si.AddFile(passSealed, name)
+	for _, si := range pio.GetVault() {
+		if si.Name == path {
+			log.Fatalf("Could not add %s: a site with that name already exists", path)
+		}
+	}
+	si, err := sealFile(path, filename, compression, &masterPub)
 	if err != nil {
-		log.Fatalf("Could not save site file: %s", err.Error())
+		log.Fatalf("Could not encrypt file: %s", err.Error())
+	}
+	si.Metadata = meta
+	err = si.AddSite()
+	if err != nil {
+		si.DiscardFile()
+		log.Fatalf("Could not save site file after file insert: %s", err.Error())
+	}
+	if err = si.CommitFile(); err != nil {
+		log.Fatalf("Could not save encrypted file: %s", err.Error())
 	}
 }
 
-// File is used to add a new file entry to the vault.
-func File(path, filename string) {
-	var c pio.ConfigFile
-	pub, priv, err := box.GenerateKey(rand.Reader)
+// Recursive adds every file below dir to the vault as its own file entry,
+// named by its path relative to dir inside of group. Entries that already
+// exist are skipped unless overwrite is set. New entries are given meta,
+// and replaced entries keep their own unless meta is set.
+func Recursive(group, dir, compression string, overwrite bool, meta pio.Metadata) {
+	group = strings.Trim(group, "/")
+	masterPub, err := masterPubKey()
 	if err != nil {
-		log.Fatalf("Could not generate site key: %s", err.Error())
+		log.Fatalf("Could not read master public key: %s", err.Error())
 	}
-
-	config, err := pio.GetConfigPath()
+	vault := pio.GetVault()
+	existing := map[string]int{}
+	for i, si := range vault {
+		existing[si.Name] = i
+	}
+	encFileDir, err := pio.GetEncryptedFilesDir()
 	if err != nil {
-		log.Fatalf("Could not get config file name: %s", err.Error())
+		log.Fatalf("Could not get encrypted file dir: %s", err.Error())
 	}
 
-	// Read the master public key.
-	configContents, err := ioutil.ReadFile(config)
+	var added, skipped int
+	var sealed []int
+	var stale []string
+	err = filepath.Walk(dir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, filename)
+		if err != nil {
+			return err
+		}
+		name := group + "/" + filepath.ToSlash(rel)
+		if !info.Mode().IsRegular() {
+			log.Printf("Skipping %s: not a regular file", filename)
+			skipped++
+			return nil
+		}
+		i, exists := existing[name]
+		if exists && !overwrite {
+			log.Printf("Skipping %s: a site with that name already exists", name)
+			skipped++
+			return nil
+		}
+		si, err := sealFile(name, filename, compression, &masterPub)
+		if err != nil {
+			return fmt.Errorf("Could not encrypt %s: %s", filename, err.Error())
+		}
+		si.Metadata = meta
+		if exists {
+			old := vault[i]
+			if old.IsFile && old.FileName != si.FileName {
+				stale = append(stale, filepath.Join(encFileDir, old.FileName))
+			}
+			if old.Created != nil {
+				si.Created = old.Created
+			}
+			if meta.IsEmpty() {
+				si.Metadata = old.Metadata
+			}
+			vault[i] = si
+		} else {
+			i = len(vault)
+			existing[name] = i
+			vault = append(vault, si)
+		}
+		sealed = append(sealed, i)
+		fmt.Println(name)
+		added++
+		return nil
+	})
+	// Save whatever was encrypted, even if the walk stopped early.
+	if uerr := pio.UpdateVault(vault); uerr != nil {
+		for _, i := range sealed {
+			vault[i].DiscardFile()
+		}
+		log.Fatalf("Could not save site file after file insert: %s", uerr.Error())
+	}
+	for _, i := range sealed {
+		if cerr := vault[i].CommitFile(); cerr != nil {
+			log.Fatalf("Could not save encrypted file for %s: %s", vault[i].Name, cerr.Error())
+		}
+	}
+	for _, p := range stale {
+		os.Remove(p)
+	}
 	if err != nil {
-		log.Fatalf("Could not get config file contents: %s", err.Error())
+		log.Fatalf("Could not insert %s: %s", dir, err.Error())
 	}
+	fmt.Printf("Inserted %d files, skipped %d\n", added, skipped)
+}
 
-	err = json.Unmarshal(configContents, &c)
+// sealFile encrypts filename in to a new file entry called path. The site
+// is not added to the vault.
+func sealFile(path, filename, compression string, masterPub *[32]byte) (si pio.SiteInfo, err error) {
+	pub, priv, err := box.GenerateKey(rand.Reader)
 	if err != nil {
-		log.Fatalf("Could not unmarshal config file contents: %s", err.Error())
+		return
 	}
-
-	masterPub := c.MasterPubKey
-
-	fileBytes, err := ioutil.ReadFile(filename)
+	in, err := os.Open(filename)
 	if err != nil {
-		log.Fatalf("Could not open and read file that is being encrypted: %s", err.Error())
+		return
 	}
-
-	fileSealed, err := pc.SealAsym([]byte(fileBytes), &masterPub, priv)
+	defer in.Close()
+	fileInfo, err := in.Stat()
 	if err != nil {
-		log.Fatalf("Could not seal file bytes: %s", err.Error())
+		return
 	}
-
-	si := pio.SiteInfo{
-		PubKey:   *pub,
-		Name:     path,
-		IsFile:   true,
-		FileName: path,
+	si = pio.SiteInfo{
+		PubKey:      *pub,
+		Name:        path,
+		IsFile:      true,
+		FileName:    path,
+		OrigName:    filepath.Base(filename),
+		Mode:        fileInfo.Mode().Perm(),
+		Compression: compression,
 	}
+	// Files are encrypted as a stream of chunks so that even very large
+	// files never need to be held in memory.
+	err = pc.SealSite(&si, in, masterPub, priv)
+	si.Touch()
+	return
+}
 
-	err = si.AddFile(fileSealed, path)
+// masterPubKey reads the master public key from the config file.
+func masterPubKey() (pub [32]byte, err error) {
+	var c pio.ConfigFile
+	config, err := pio.GetConfigPath()
 	if err != nil {
-		log.Fatalf("Could not save site file after file insert: %s", err.Error())
+		return
+	}
+	configContents, err := ioutil.ReadFile(config)
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(configContents, &c); err != nil {
+		return
 	}
+	return c.MasterPubKey, nil
 }
@@ -0,0 +1,68 @@
+// Package vaulttest sets up passgo vaults for tests.
+package vaulttest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/initialize"
+	"github.com/ejcx/passgo/v2/insert"
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// MasterPassword is the master password of vaults created by New.
+const MasterPassword = "master"
+
+// New creates an empty vault in a new temporary directory and points
+// PASSGODIR at it. It returns the temporary directory, which tests can
+// keep other files in, and a function that removes it again.
+func New(t *testing.T) (dir string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "passgo")
+	if err != nil {
+		t.Fatalf("Could not create temp dir: %s", err)
+	}
+	os.Setenv(pio.PASSGODIR, filepath.Join(dir, "vault"))
+	pio.Input = pio.NewStreamPrompter(strings.NewReader(MasterPassword+"\n"), ioutil.Discard)
+	initialize.Init()
+	return dir, func() {
+		os.Unsetenv(pio.PASSGODIR)
+		os.RemoveAll(dir)
+	}
+}
+
+// Unlock returns the master private key of the vault, answering the
+// master password prompt with MasterPassword.
+func Unlock() [32]byte {
+	pio.Input = pio.NewStreamPrompter(strings.NewReader(MasterPassword+"\n"), ioutil.Discard)
+	return pc.GetMasterKey()
+}
+
+// AddPassword adds a password site to the vault.
+func AddPassword(t *testing.T, name, password string, meta pio.Metadata) {
+	if err := insert.SealPassword(name, password, meta); err != nil {
+		t.Fatalf("Could not add %s: %s", name, err)
+	}
+}
+
+// BreakConfig puts a directory in place of the config file, so that adding
+// sites fails, and returns a function that puts the config file back.
+func BreakConfig(t *testing.T) (restore func()) {
+	config, err := pio.GetConfigPath()
+	if err != nil {
+		t.Fatalf("Could not get config path: %s", err)
+	}
+	if err = os.Rename(config, config+".bak"); err != nil {
+		t.Fatalf("Could not move config file: %s", err)
+	}
+	if err = os.Mkdir(config, 0700); err != nil {
+		t.Fatalf("Could not create directory in place of config file: %s", err)
+	}
+	return func() {
+		os.Remove(config)
+		os.Rename(config+".bak", config)
+	}
+}
@@ -0,0 +1,164 @@
+// Package keyfile adds a keyfile to an existing vault, or removes it, so
+// that both the master password and the keyfile are needed to unlock it.
+package keyfile
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// Set makes the vault need the keyfile at path as well as the master
+// password. The keyfile is created if it does not exist, and replaces
+// any keyfile the vault needed before.
+func Set(path string) {
+	c, pass, masterPrivKey := unlock()
+	path, err := pc.CreateKeyfile(path)
+	if err != nil {
+		log.Fatalf("Could not create keyfile: %s", err.Error())
+	}
+	// $PASSGO_KEYFILE was only needed to find the old keyfile.
+	os.Unsetenv(pio.KeyfileEnv)
+	c.Keyfile = path
+	// Resealing the master key alone would leave old copies of the config,
+	// such as in git history, unlocking the vault without the keyfile.
+	rekey(&c, pass, &masterPrivKey)
+	fmt.Printf("The vault now needs %s to unlock. Keep a copy of it somewhere safe, but not with the vault.\n", path)
+	fmt.Println("Every site was encrypted again with a new master key, so paper backups and recovery shares made before no longer work. Copies of the vault made before, such as in git history, still open with the master password alone.")
+}
+
+// Remove makes the vault need only the master password again.
+func Remove() {
+	c, pass, masterPrivKey := unlock()
+	if c.Keyfile == "" {
+		log.Fatalf("Could not remove keyfile: The vault does not have one")
+	}
+	c.Keyfile = ""
+	reseal(&c, pass, &masterPrivKey)
+	fmt.Println("The vault no longer needs a keyfile to unlock")
+}
+
+func unlock() (c pio.ConfigFile, pass string, masterPrivKey [32]byte) {
+	c, err := pio.ReadConfig()
+	if err != nil {
+		log.Fatalf("Could not read config file: %s", err.Error())
+	}
+	pass, err = pio.PromptMasterPass(pio.MasterPassPrompt)
+	if err != nil {
+		log.Fatalf("Could not get master password: %s", err.Error())
+	}
+	if masterPrivKey, err = pc.OpenMasterKey(pass, &c); err != nil {
+		log.Fatalf("Could not unlock vault: %s", err.Error())
+	}
+	return
+}
+
+func reseal(c *pio.ConfigFile, pass string, masterPrivKey *[32]byte) {
+	if err := pc.SealMasterKey(pass, masterPrivKey, c); err != nil {
+		log.Fatalf("Could not encrypt master key: %s", err.Error())
+	}
+	if err := c.SaveFile(); err != nil {
+		log.Fatalf("Could not write to config file: %s", err.Error())
+	}
+}
+
+// rekey replaces the master key pair of the vault with a new one sealed in
+// to c, and encrypts every site again for it.
+func rekey(c *pio.ConfigFile, pass string, oldPrivKey *[32]byte) {
+	masterPub, masterPriv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Fatalf("Could not generate master key: %s", err.Error())
+	}
+	old := pio.GetVault()
+	vault := append(pio.SiteFile{}, old...)
+	discard := func() {
+		for i := range vault {
+			vault[i].DiscardFile()
+		}
+	}
+	for i := range vault {
+		if err = reencrypt(&vault[i], oldPrivKey, masterPub); err != nil {
+			discard()
+			log.Fatalf("Could not encrypt %s again: %s", vault[i].Name, err.Error())
+		}
+	}
+	c.MasterPubKey = *masterPub
+	if err = pc.SealMasterKey(pass, masterPriv, c); err != nil {
+		discard()
+		log.Fatalf("Could not encrypt master key: %s", err.Error())
+	}
+
+	// The sites can only be opened with the config that matches them, so
+	// the old config is kept until both have been written. If passgo is
+	// stopped in between, moving it back opens the old vault again.
+	config, err := pio.GetConfigPath()
+	if err != nil {
+		discard()
+		log.Fatalf("Could not get config file path: %s", err.Error())
+	}
+	oldConfig, err := ioutil.ReadFile(config)
+	if err != nil {
+		discard()
+		log.Fatalf("Could not read config file: %s", err.Error())
+	}
+	backup := config + ".old"
+	if err = ioutil.WriteFile(backup, oldConfig, 0600); err != nil {
+		discard()
+		log.Fatalf("Could not keep a copy of the config file: %s", err.Error())
+	}
+	restore := func() {
+		discard()
+		pio.UpdateVault(old)
+		if err := ioutil.WriteFile(config, oldConfig, 0600); err != nil {
+			log.Fatalf("Could not put the old config file back, move %s to %s: %s", backup, config, err.Error())
+		}
+		os.Remove(backup)
+	}
+	if err = c.SaveFile(); err != nil {
+		restore()
+		log.Fatalf("Could not write to config file: %s", err.Error())
+	}
+	if err = pio.UpdateVault(vault); err != nil {
+		restore()
+		log.Fatalf("Could not update password vault: %s", err.Error())
+	}
+	for i := range vault {
+		if err = vault[i].CommitFile(); err != nil {
+			log.Fatalf("Could not save encrypted file for %s: %s", vault[i].Name, err.Error())
+		}
+	}
+	os.Remove(backup)
+}
+
+// reencrypt decrypts site with oldPrivKey and encrypts it again with a new
+// site key for masterPub. File entries still need to be committed.
+func reencrypt(site *pio.SiteInfo, oldPrivKey, masterPub *[32]byte) error {
+	r, err := pc.OpenSite(site, oldPrivKey)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	pub, priv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	if site.IsFile {
+		err = pc.SealSite(site, r, masterPub, priv)
+	} else {
+		var secret []byte
+		if secret, err = ioutil.ReadAll(r); err == nil {
+			site.PassSealed, err = pc.SealAsym(secret, masterPub, priv)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	site.PubKey = *pub
+	return nil
+}
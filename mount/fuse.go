@@ -0,0 +1,195 @@
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package mount
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"path"
+	"sort"
+	"syscall"
+	"time"
+
+	"bazil.org/fuse"
+	"bazil.org/fuse/fs"
+	"github.com/ejcx/passgo/v2/pc"
+)
+
+// Mount unlocks the vault and serves it at dir until passgo is
+// interrupted, or nothing has been read for timeout if it is not 0. The
+// vault is then locked and unmounted.
+func Mount(dir string, timeout time.Duration) error {
+	vault := New(pc.GetMasterKey())
+	c, err := fuse.Mount(dir,
+		fuse.FSName("passgo"),
+		fuse.Subtype("passgo"),
+		fuse.ReadOnly(),
+		fuse.LocalVolume(),
+		fuse.VolumeName("passgo"),
+	)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fs.Serve(c, fuseFS{vault})
+	}()
+	<-c.Ready
+	if err = c.MountError; err != nil {
+		return err
+	}
+	log.Printf("Mounted the vault on %s", dir)
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	var idle <-chan time.Time
+	if timeout > 0 {
+		ticker := time.NewTicker(timeout / 10)
+		defer ticker.Stop()
+		idle = ticker.C
+	}
+	for {
+		select {
+		case err = <-done:
+			// Unmounted from outside passgo.
+			vault.Lock()
+			return err
+		case <-stop:
+		case <-idle:
+			if vault.Idle() < timeout {
+				continue
+			}
+			log.Printf("Locking the vault after %s without use", timeout)
+		}
+		vault.Lock()
+		if err = fuse.Unmount(dir); err != nil {
+			return err
+		}
+		return <-done
+	}
+}
+
+// fuseFS serves an FS with bazil.org/fuse. Nodes are looked up by path on
+// every request, so they see sites that were changed after they were
+// created.
+type fuseFS struct {
+	vault *FS
+}
+
+type dirNode struct {
+	vault *FS
+	path  string
+}
+
+type fileNode struct {
+	vault *FS
+	path  string
+}
+
+// fileHandle is an open file, holding its decrypted contents.
+type fileHandle struct {
+	contents []byte
+}
+
+func (f fuseFS) Root() (fs.Node, error) {
+	return dirNode{f.vault, ""}, nil
+}
+
+func (d dirNode) Attr(ctx context.Context, a *fuse.Attr) error {
+	dir, _ := d.vault.Lookup(d.path)
+	if dir == nil {
+		return fuse.ENOENT
+	}
+	a.Mode = os.ModeDir | 0500
+	a.Uid = uint32(os.Getuid())
+	a.Gid = uint32(os.Getgid())
+	return nil
+}
+
+func (d dirNode) Lookup(ctx context.Context, name string) (fs.Node, error) {
+	p := path.Join(d.path, name)
+	dir, file := d.vault.Lookup(p)
+	switch {
+	case dir != nil:
+		return dirNode{d.vault, p}, nil
+	case file != nil:
+		return fileNode{d.vault, p}, nil
+	}
+	return nil, fuse.ENOENT
+}
+
+func (d dirNode) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
+	dir, _ := d.vault.Lookup(d.path)
+	if dir == nil {
+		return nil, fuse.ENOENT
+	}
+	var entries []fuse.Dirent
+	for name := range dir.Dirs {
+		entries = append(entries, fuse.Dirent{Name: name, Type: fuse.DT_Dir})
+	}
+	for name := range dir.Files {
+		entries = append(entries, fuse.Dirent{Name: name, Type: fuse.DT_File})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
+}
+
+func (f fileNode) Attr(ctx context.Context, a *fuse.Attr) error {
+	_, file := f.vault.Lookup(f.path)
+	if file == nil {
+		return fuse.ENOENT
+	}
+	a.Mode = 0400
+	a.Size = uint64(file.Size)
+	a.Uid = uint32(os.Getuid())
+	a.Gid = uint32(os.Getgid())
+	if file.Site.Modified != nil {
+		a.Mtime = *file.Site.Modified
+	}
+	if file.Site.Created != nil {
+		a.Crtime = *file.Site.Created
+	}
+	return nil
+}
+
+func (f fileNode) Open(ctx context.Context, req *fuse.OpenRequest, resp *fuse.OpenResponse) (fs.Handle, error) {
+	if !req.Flags.IsReadOnly() {
+		return nil, fuse.Errno(syscall.EROFS)
+	}
+	_, file := f.vault.Lookup(f.path)
+	if file == nil {
+		return nil, fuse.ENOENT
+	}
+	contents, err := f.vault.Read(file)
+	if err == ErrLocked {
+		return nil, fuse.Errno(syscall.EACCES)
+	}
+	if err == ErrTooLarge {
+		return nil, fuse.Errno(syscall.EFBIG)
+	}
+	if err != nil {
+		log.Printf("Could not decrypt %s: %s", f.path, err.Error())
+		return nil, fuse.EIO
+	}
+	// Keep decrypted sites out of the page cache.
+	resp.Flags |= fuse.OpenDirectIO
+	return &fileHandle{contents}, nil
+}
+
+func (h *fileHandle) ReadAll(ctx context.Context) ([]byte, error) {
+	return h.contents, nil
+}
+
+func (h *fileHandle) Release(ctx context.Context, req *fuse.ReleaseRequest) error {
+	for i := range h.contents {
+		h.contents[i] = 0
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package mount
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"testing"
+
+	"bazil.org/fuse"
+	"bazil.org/fuse/fs"
+	"github.com/ejcx/passgo/v2/internal/vaulttest"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// The nodes are called directly, so no FUSE device is needed.
+func TestFuseNodes(t *testing.T) {
+	vault, cleanup := newFS(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	root, _ := fuseFS{vault}.Root()
+	var a fuse.Attr
+	if err := root.Attr(ctx, &a); err != nil || a.Mode != os.ModeDir|0500 {
+		t.Fatalf("Root attr %v, %v", a.Mode, err)
+	}
+	money, err := root.(fs.NodeStringLookuper).Lookup(ctx, "money")
+	if err != nil {
+		t.Fatalf("Could not look up money: %s", err)
+	}
+	if _, err = root.(fs.NodeStringLookuper).Lookup(ctx, "missing"); err != fuse.ENOENT {
+		t.Fatalf("Lookup of a missing group returned %v", err)
+	}
+	entries, err := money.(fs.HandleReadDirAller).ReadDirAll(ctx)
+	if err != nil || len(entries) != 2 || entries[0].Name != "bank.com" || entries[1].Name != "budget.csv" {
+		t.Fatalf("ReadDirAll returned %+v, %v", entries, err)
+	}
+
+	bank, err := money.(fs.NodeStringLookuper).Lookup(ctx, "bank.com")
+	if err != nil {
+		t.Fatalf("Could not look up bank.com: %s", err)
+	}
+	if err = bank.Attr(ctx, &a); err != nil || a.Mode != 0400 || a.Size != 7 || a.Mtime.IsZero() {
+		t.Fatalf("bank.com attr %+v, %v", a, err)
+	}
+	open := func(flags fuse.OpenFlags) (fs.Handle, *fuse.OpenResponse, error) {
+		resp := &fuse.OpenResponse{}
+		h, err := bank.(fs.NodeOpener).Open(ctx, &fuse.OpenRequest{Flags: flags}, resp)
+		return h, resp, err
+	}
+	if _, _, err = open(fuse.OpenReadWrite); err != fuse.Errno(syscall.EROFS) {
+		t.Errorf("Opening for writing returned %v", err)
+	}
+	h, resp, err := open(fuse.OpenReadOnly)
+	if err != nil {
+		t.Fatalf("Could not open bank.com: %s", err)
+	}
+	if resp.Flags&fuse.OpenDirectIO == 0 {
+		t.Errorf("bank.com was not opened with direct IO")
+	}
+	contents, err := h.(fs.HandleReadAller).ReadAll(ctx)
+	if err != nil || string(contents) != "hunter2" {
+		t.Fatalf("ReadAll returned %q, %v", contents, err)
+	}
+	h.(fs.HandleReleaser).Release(ctx, &fuse.ReleaseRequest{})
+	if string(contents) == "hunter2" {
+		t.Errorf("Release did not clear the decrypted contents")
+	}
+
+	// Nodes see changes made after they were looked up.
+	vaulttest.AddPassword(t, "money/mint.com", "mintpass", pio.Metadata{})
+	if entries, _ = money.(fs.HandleReadDirAller).ReadDirAll(ctx); len(entries) != 3 {
+		t.Errorf("New site not listed: %+v", entries)
+	}
+
+	defer func(n int64) { maxRead = n }(maxRead)
+	maxRead = 4
+	if _, _, err = open(fuse.OpenReadOnly); err != fuse.Errno(syscall.EFBIG) {
+		t.Errorf("Opening a site over maxRead returned %v", err)
+	}
+
+	vault.Lock()
+	if _, _, err = open(fuse.OpenReadOnly); err != fuse.Errno(syscall.EACCES) {
+		t.Errorf("Opening after Lock returned %v", err)
+	}
+}
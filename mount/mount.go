@@ -0,0 +1,191 @@
+// Package mount presents the vault as a read-only filesystem, with groups
+// as directories and sites as files that are decrypted when they are read.
+package mount
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+	"github.com/ejcx/passgo/v2/stats"
+)
+
+// ErrLocked is returned when a site is read after the filesystem has been
+// locked.
+var ErrLocked = errors.New("The vault is locked")
+
+// ErrTooLarge is returned when a site is too large to be held in memory
+// while it is open.
+var ErrTooLarge = errors.New("The site is too large to read")
+
+// maxRead is the size of the largest site that can be read.
+var maxRead int64 = 64 << 20
+
+// Dir is a group in the vault.
+type Dir struct {
+	Name  string
+	Dirs  map[string]*Dir
+	Files map[string]*File
+}
+
+// File is a site in the vault.
+type File struct {
+	Name string
+	Site pio.SiteInfo
+	// Size is the size of the decrypted site.
+	Size int64
+}
+
+// NewTree returns the groups and sites of the vault as a tree. A site
+// with the same path as a group, such as work next to work/vpn, is left
+// out, since a name can not be both a directory and a file.
+func NewTree(sites []pio.SiteInfo) *Dir {
+	root := newDir("")
+	for _, site := range sites {
+		parts := strings.Split(pio.CleanPath(site.Name), "/")
+		d := root
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := d.Dirs[part]
+			if !ok {
+				child = newDir(part)
+				d.Dirs[part] = child
+			}
+			delete(d.Files, part)
+			d = child
+		}
+		name := parts[len(parts)-1]
+		if _, ok := d.Dirs[name]; !ok {
+			d.Files[name] = &File{Name: name, Site: site, Size: size(site)}
+		}
+	}
+	return root
+}
+
+func newDir(name string) *Dir {
+	return &Dir{Name: name, Dirs: map[string]*Dir{}, Files: map[string]*File{}}
+}
+
+// size returns the size of site once it is decrypted.
+func size(site pio.SiteInfo) int64 {
+	if !site.IsFile {
+		return pc.PayloadSize(&site, int64(len(site.PassSealed)))
+	}
+	if encFileDir, err := pio.GetEncryptedFilesDir(); err == nil {
+		if fi, err := os.Stat(filepath.Join(encFileDir, site.FileName)); err == nil {
+			return stats.Original(&site, fi.Size())
+		}
+	}
+	return site.Size
+}
+
+// FS is the vault unlocked with the master private key. Sites are only
+// decrypted when they are read, until the filesystem is locked.
+type FS struct {
+	mu            sync.Mutex
+	masterPrivKey [32]byte
+	locked        bool
+	// lastUsed is when a site was last read.
+	lastUsed time.Time
+
+	root     *Dir
+	modified time.Time
+}
+
+// New returns a filesystem for the vault, unlocked with masterPrivKey.
+func New(masterPrivKey [32]byte) *FS {
+	fs := &FS{masterPrivKey: masterPrivKey, lastUsed: time.Now()}
+	fs.Root()
+	return fs
+}
+
+// Root returns the top of the vault. The vault is read again when it has
+// changed since the last call, so new sites show up while mounted.
+func (fs *FS) Root() *Dir {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	sitesFile, err := pio.GetSitesFile()
+	if err != nil {
+		return fs.root
+	}
+	fi, err := os.Stat(sitesFile)
+	if err != nil || (fs.root != nil && !fi.ModTime().After(fs.modified)) {
+		return fs.root
+	}
+	fs.root = NewTree(pio.GetVault())
+	fs.modified = fi.ModTime()
+	return fs.root
+}
+
+// Lookup returns the group or site at path, or nil for both if there is
+// nothing there.
+func (fs *FS) Lookup(path string) (*Dir, *File) {
+	d := fs.Root()
+	path = pio.CleanPath(path)
+	if path == "" {
+		return d, nil
+	}
+	parts := strings.Split(path, "/")
+	for _, part := range parts[:len(parts)-1] {
+		if d = d.Dirs[part]; d == nil {
+			return nil, nil
+		}
+	}
+	name := parts[len(parts)-1]
+	if child, ok := d.Dirs[name]; ok {
+		return child, nil
+	}
+	return nil, d.Files[name]
+}
+
+// Read returns the decrypted contents of f, or ErrTooLarge if they are
+// larger than maxRead.
+func (fs *FS) Read(f *File) ([]byte, error) {
+	fs.mu.Lock()
+	if fs.locked {
+		fs.mu.Unlock()
+		return nil, ErrLocked
+	}
+	if f.Size > maxRead {
+		fs.mu.Unlock()
+		return nil, ErrTooLarge
+	}
+	fs.lastUsed = time.Now()
+	key := fs.masterPrivKey
+	fs.mu.Unlock()
+
+	site := f.Site
+	r, err := pc.OpenSite(&site, &key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	contents, err := ioutil.ReadAll(io.LimitReader(r, maxRead+1))
+	if err == nil && int64(len(contents)) > maxRead {
+		return nil, ErrTooLarge
+	}
+	return contents, err
+}
+
+// Lock forgets the master private key, so no more sites can be read.
+func (fs *FS) Lock() {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.masterPrivKey = [32]byte{}
+	fs.locked = true
+}
+
+// Idle returns how long it has been since a site was last read. Listing
+// the vault, which file managers and shells do on their own, does not
+// count.
+func (fs *FS) Idle() time.Duration {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return time.Since(fs.lastUsed)
+}
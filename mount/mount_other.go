@@ -0,0 +1,15 @@
+//go:build !linux && !darwin && !freebsd
+// +build !linux,!darwin,!freebsd
+
+package mount
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// Mount is only supported where FUSE is.
+func Mount(dir string, timeout time.Duration) error {
+	return fmt.Errorf("Mounting is not supported on %s", runtime.GOOS)
+}
@@ -0,0 +1,104 @@
+package mount
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ejcx/passgo/v2/insert"
+	"github.com/ejcx/passgo/v2/internal/vaulttest"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// newFS creates a vault with a password and a file entry, and returns it
+// as a filesystem.
+func newFS(t *testing.T) (vault *FS, cleanup func()) {
+	dir, cleanup := vaulttest.New(t)
+	vaulttest.AddPassword(t, "money/bank.com", "hunter2", pio.Metadata{})
+	csv := filepath.Join(dir, "budget.csv")
+	ioutil.WriteFile(csv, []byte("date,amount\n2019-01-01,10.00\n"), 0600)
+	insert.File("money/budget.csv", csv, pio.CompressionGzip, pio.Metadata{})
+	return New(vaulttest.Unlock()), cleanup
+}
+
+func TestNewTree(t *testing.T) {
+	root := NewTree([]pio.SiteInfo{
+		{Name: "work", PassSealed: make([]byte, 50)},
+		{Name: "work/vpn", PassSealed: make([]byte, 50)},
+		{Name: "home/wifi", PassSealed: make([]byte, 57)},
+		{Name: "email"},
+	})
+	if len(root.Dirs) != 2 || root.Dirs["work"] == nil || root.Dirs["home"] == nil {
+		t.Fatalf("Root has directories %v", root.Dirs)
+	}
+	if len(root.Files) != 1 || root.Files["email"] == nil {
+		t.Fatalf("Root has files %v, expected only email", root.Files)
+	}
+	if f := root.Dirs["home"].Files["wifi"]; f == nil || f.Size != 57-24-16 {
+		t.Fatalf("home/wifi is %+v", f)
+	}
+}
+
+func TestFS(t *testing.T) {
+	vault, cleanup := newFS(t)
+	defer cleanup()
+
+	if dir, file := vault.Lookup("money"); dir == nil || file != nil || len(dir.Files) != 2 {
+		t.Fatalf("Lookup(money) returned %v, %v", dir, file)
+	}
+	if dir, file := vault.Lookup("money/missing"); dir != nil || file != nil {
+		t.Fatalf("Lookup of a missing site returned %v, %v", dir, file)
+	}
+	for name, want := range map[string]string{
+		"money/bank.com":   "hunter2",
+		"money/budget.csv": "date,amount\n2019-01-01,10.00\n",
+	} {
+		_, file := vault.Lookup(name)
+		if file == nil || file.Size != int64(len(want)) {
+			t.Fatalf("Lookup(%s) returned %+v", name, file)
+		}
+		if b, err := vault.Read(file); err != nil || string(b) != want {
+			t.Errorf("Read(%s) returned %q, %v", name, b, err)
+		}
+	}
+
+	// Sites added while mounted show up.
+	vaulttest.AddPassword(t, "email/gmail.com", "gmailpass", pio.Metadata{})
+	if _, file := vault.Lookup("email/gmail.com"); file == nil {
+		t.Errorf("New site not found")
+	}
+
+	// Only reading a site counts as using the vault.
+	vault.lastUsed = time.Now().Add(-time.Hour)
+	_, file := vault.Lookup("money/bank.com")
+	if vault.Idle() < time.Hour {
+		t.Errorf("Lookup reset the idle time")
+	}
+	vault.Read(file)
+	if vault.Idle() > time.Minute {
+		t.Errorf("Read did not reset the idle time")
+	}
+
+	// Sites larger than maxRead are not read, even when their size is
+	// not known up front.
+	defer func(n int64) { maxRead = n }(maxRead)
+	maxRead = 8
+	_, large := vault.Lookup("money/budget.csv")
+	if _, err := vault.Read(large); err != ErrTooLarge {
+		t.Errorf("Read of a site over maxRead returned %v", err)
+	}
+	unknown := *large
+	unknown.Size = 0
+	if _, err := vault.Read(&unknown); err != ErrTooLarge {
+		t.Errorf("Read of a site over maxRead with an unknown size returned %v", err)
+	}
+	if _, err := vault.Read(file); err != nil {
+		t.Errorf("Read of a site under maxRead returned %v", err)
+	}
+
+	vault.Lock()
+	if _, err := vault.Read(file); err != ErrLocked {
+		t.Errorf("Read after Lock returned %v", err)
+	}
+}
@@ -0,0 +1,194 @@
+// Package nativehost lets a browser extension autofill from passgo using
+// the Chrome and Firefox native messaging protocol. The host never
+// unlocks the vault itself: credentials are read from a running
+// passgo serve, which asks for approval of every read.
+package nativehost
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/ejcx/passgo/v2/format"
+)
+
+// MaxMessageSize is the largest message that is read or written. Browsers
+// refuse messages from the host over 1MB.
+const MaxMessageSize = 1024 * 1024
+
+// Vault is where credentials come from, usually a serve.Client.
+type Vault interface {
+	List(group string) ([]format.Site, error)
+	Get(name string) (format.Secret, error)
+}
+
+// Request is a message from the extension. Action is "status" to check
+// that passgo is unlocked, or "credentials" to get the logins for URL.
+type Request struct {
+	Action string `json:"action"`
+	URL    string `json:"url,omitempty"`
+}
+
+// Response is the answer to a Request. Error is set if it failed.
+type Response struct {
+	Unlocked    bool         `json:"unlocked,omitempty"`
+	Credentials []Credential `json:"credentials,omitempty"`
+	Error       string       `json:"error,omitempty"`
+}
+
+// Credential is a login found for a URL.
+type Credential struct {
+	Name     string `json:"name"`
+	URL      string `json:"url,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password"`
+}
+
+// ReadMessage reads one length prefixed JSON message into v. It returns
+// io.EOF when the browser closes the connection.
+func ReadMessage(r io.Reader, v interface{}) error {
+	var size uint32
+	// The length is in native byte order, which is little endian on every
+	// platform browsers run on.
+	if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return errors.New("Message length cut short")
+		}
+		return err
+	}
+	if size > MaxMessageSize {
+		return fmt.Errorf("Message of %d bytes is too large", size)
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return fmt.Errorf("Could not read message: %s", err.Error())
+	}
+	return json.Unmarshal(b, v)
+}
+
+// WriteMessage writes v as one length prefixed JSON message.
+func WriteMessage(w io.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if len(b) > MaxMessageSize {
+		return fmt.Errorf("Message of %d bytes is too large", len(b))
+	}
+	if err = binary.Write(w, binary.LittleEndian, uint32(len(b))); err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// Run answers requests read from r until it is closed.
+func Run(r io.Reader, w io.Writer, v Vault) error {
+	for {
+		var req Request
+		err := ReadMessage(r, &req)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = WriteMessage(w, Handle(v, req)); err != nil {
+			return err
+		}
+	}
+}
+
+// Handle answers a single request.
+func Handle(v Vault, req Request) Response {
+	switch req.Action {
+	case "status":
+		if _, err := v.List(""); err != nil {
+			return Response{Error: locked(err)}
+		}
+		return Response{Unlocked: true}
+	case "credentials":
+		creds, err := Credentials(v, req.URL)
+		if err != nil {
+			return Response{Error: err.Error()}
+		}
+		return Response{Unlocked: true, Credentials: creds}
+	}
+	return Response{Error: fmt.Sprintf("Unknown action %q", req.Action)}
+}
+
+// Credentials returns the logins for rawurl. Sites that were denied by
+// the user are left out.
+func Credentials(v Vault, rawurl string) ([]Credential, error) {
+	host := Hostname(rawurl)
+	if host == "" {
+		return nil, fmt.Errorf("No hostname in %q", rawurl)
+	}
+	sites, err := v.List("")
+	if err != nil {
+		return nil, errors.New(locked(err))
+	}
+	creds := []Credential{}
+	for _, site := range Match(sites, host) {
+		secret, err := v.Get(site.Name)
+		if err != nil {
+			continue
+		}
+		creds = append(creds, Credential{
+			Name:     site.Name,
+			URL:      site.URL,
+			Username: site.Username,
+			// Only the first line is the password, the rest are notes.
+			Password: strings.SplitN(secret.Secret, "\n", 2)[0],
+		})
+	}
+	return creds, nil
+}
+
+func locked(err error) string {
+	return fmt.Sprintf("passgo is locked, start passgo serve --socket: %s", err.Error())
+}
+
+// Match returns the password sites for host. A site matches if the host
+// of its URL, or its name when it has no URL, is host or a parent domain
+// of it, so bank.com matches www.bank.com but not mybank.com. Names without
+// a dot never match.
+func Match(sites []format.Site, host string) []format.Site {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	var matches []format.Site
+	for _, site := range sites {
+		if site.Type != format.TypePassword {
+			continue
+		}
+		domain := path.Base(site.Name)
+		if site.URL != "" {
+			domain = Hostname(site.URL)
+		} else if !strings.Contains(domain, ".") {
+			// Names like work/sso are not domains, and a site called
+			// com must not match every .com host.
+			continue
+		}
+		domain = strings.ToLower(domain)
+		if domain != "" && (host == domain || strings.HasSuffix(host, "."+domain)) {
+			matches = append(matches, site)
+		}
+	}
+	return matches
+}
+
+// Hostname returns the host of rawurl, which may leave out the scheme.
+func Hostname(rawurl string) string {
+	if !strings.Contains(rawurl, "://") {
+		rawurl = "https://" + rawurl
+	}
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
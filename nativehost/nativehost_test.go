@@ -0,0 +1,135 @@
+package nativehost
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/format"
+)
+
+// fakeVault holds passwords by name. Reads of sites in denied fail, as if
+// the user did not approve them.
+type fakeVault struct {
+	sites  []format.Site
+	pass   map[string]string
+	denied map[string]bool
+	err    error
+}
+
+func (f *fakeVault) List(group string) ([]format.Site, error) {
+	return f.sites, f.err
+}
+
+func (f *fakeVault) Get(name string) (format.Secret, error) {
+	if f.denied[name] {
+		return format.Secret{}, errors.New("Reading " + name + " was not approved")
+	}
+	return format.Secret{Secret: f.pass[name]}, nil
+}
+
+func newFakeVault() *fakeVault {
+	return &fakeVault{
+		sites: []format.Site{
+			{Name: "money/bank.com", Type: format.TypePassword, Username: "alice"},
+			{Name: "work/sso", Type: format.TypePassword, URL: "https://login.corp.example.com/", Username: "alice@corp"},
+			{Name: "work/wiki", Type: format.TypePassword, URL: "wiki.example.com"},
+			{Name: "money/mybank.com", Type: format.TypePassword},
+			{Name: "certs/bank.com", Type: format.TypeFile},
+			{Name: "misc/com", Type: format.TypePassword},
+		},
+		pass: map[string]string{
+			"money/bank.com": "hunter2\nsecurity question: blue",
+			"work/sso":       "ssopass",
+			"work/wiki":      "wikipass",
+		},
+		denied: map[string]bool{},
+	}
+}
+
+func names(sites []format.Site) (n []string) {
+	for _, site := range sites {
+		n = append(n, site.Name)
+	}
+	return
+}
+
+func TestMatch(t *testing.T) {
+	sites := newFakeVault().sites
+	tests := map[string][]string{
+		"bank.com":               {"money/bank.com"},
+		"www.BANK.com.":          {"money/bank.com"},
+		"login.corp.example.com": {"work/sso"},
+		"corp.example.com":       nil,
+		"wiki.example.com":       {"work/wiki"},
+		"evil-wiki.example.com":  nil,
+		"mybank.com":             {"money/mybank.com"},
+		"notbank.com":            nil,
+		"bank.com.attacker.test": nil,
+	}
+	for host, want := range tests {
+		if got := names(Match(sites, host)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Match(%q) = %v, expected %v", host, got, want)
+		}
+	}
+}
+
+func TestMessages(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteMessage(&buf, Request{Action: "status"}); err != nil {
+		t.Fatalf("Could not write message: %s", err)
+	}
+	want := "\x13\x00\x00\x00" + `{"action":"status"}`
+	if buf.String() != want {
+		t.Fatalf("Wrote %q, expected %q", buf.String(), want)
+	}
+	var req Request
+	if err := ReadMessage(&buf, &req); err != nil || req.Action != "status" {
+		t.Fatalf("Read %+v, %v", req, err)
+	}
+	if err := ReadMessage(&buf, &req); err != io.EOF {
+		t.Fatalf("Reading at end returned %v", err)
+	}
+	if err := ReadMessage(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0x7f}), &req); err == nil {
+		t.Fatalf("Oversized message was read")
+	}
+	if err := ReadMessage(bytes.NewReader([]byte{10, 0, 0, 0, '{'}), &req); err == nil {
+		t.Fatalf("Truncated message was read")
+	}
+}
+
+func TestRun(t *testing.T) {
+	v := newFakeVault()
+	v.denied["work/wiki"] = true
+	var in, out bytes.Buffer
+	WriteMessage(&in, Request{Action: "status"})
+	WriteMessage(&in, Request{Action: "credentials", URL: "https://www.bank.com/login?next=/"})
+	WriteMessage(&in, Request{Action: "credentials", URL: "https://wiki.example.com/"})
+	WriteMessage(&in, Request{Action: "delete"})
+	if err := Run(&in, &out, v); err != nil {
+		t.Fatalf("Run returned %s", err)
+	}
+
+	want := []Response{
+		{Unlocked: true},
+		{Unlocked: true, Credentials: []Credential{{Name: "money/bank.com", Username: "alice", Password: "hunter2"}}},
+		{Unlocked: true},
+		{Error: `Unknown action "delete"`},
+	}
+	for i, w := range want {
+		var got Response
+		if err := ReadMessage(&out, &got); err != nil {
+			t.Fatalf("Could not read response %d: %s", i, err)
+		}
+		if !reflect.DeepEqual(got, w) {
+			t.Errorf("Response %d is %+v, expected %+v", i, got, w)
+		}
+	}
+
+	v.err = errors.New("dial unix passgo.sock: connect: no such file or directory")
+	if resp := Handle(v, Request{Action: "credentials", URL: "bank.com"}); resp.Error == "" || resp.Unlocked {
+		t.Errorf("Locked vault returned %+v", resp)
+	}
+}
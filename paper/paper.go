@@ -0,0 +1,265 @@
+// Package paper renders the sealed master key of a vault as a document
+// that can be printed, and rebuilds the config file from it.
+package paper
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+const (
+	// groupLen and lineGroups are how hex values are laid out so they
+	// are easy to type back in.
+	groupLen   = 4
+	lineGroups = 8
+	// sealedKeyLen is the length of the master private key sealed by
+	// pc.Seal: a nonce, the key and a tag.
+	sealedKeyLen = 24 + 32 + 16
+)
+
+var (
+	kdf = fmt.Sprintf("scrypt N=%d r=%d p=%d", pc.ScryptN, pc.ScryptR, pc.ScryptP)
+
+	header = `passgo paper backup
+
+This is the master private key of a passgo vault, sealed with the master
+password. Together with sites.json and the files directory of the vault,
+it recovers the vault after every device it was on is lost:
+
+    passgo paper-restore backup.txt
+
+It is useless without the master password, but keep it somewhere safe
+anyway. The QR code, if there is one, holds the same fields as below,
+and can be scanned in to a text file to restore from.
+
+`
+)
+
+// Fields returns the part of the backup that is needed to restore it.
+func Fields(c pio.ConfigFile) string {
+	var b strings.Builder
+	writeField(&b, "kdf", []string{kdf})
+	if c.Keyfile != "" {
+		writeField(&b, "keyfile", []string{c.Keyfile})
+	}
+	writeField(&b, "public-key", groupHex(c.MasterPubKey[:]))
+	writeField(&b, "password-salt", groupHex(c.MasterPassKeySalt[:]))
+	writeField(&b, "sealed-private-key", groupHex(c.MasterKeyPrivSealed))
+	writeField(&b, "checksum", groupHex(checksum(c)))
+	return b.String()
+}
+
+// QRText returns the fields of the backup without the spacing that makes
+// them easy to type, which is what QR codes hold.
+func QRText(c pio.ConfigFile) string {
+	text := "kdf: " + kdf + "\n"
+	if c.Keyfile != "" {
+		text += "keyfile: " + c.Keyfile + "\n"
+	}
+	return text + fmt.Sprintf("public-key: %x\npassword-salt: %x\nsealed-private-key: %x\nchecksum: %x\n",
+		c.MasterPubKey, c.MasterPassKeySalt, c.MasterKeyPrivSealed, checksum(c))
+}
+
+// Render returns the printable backup of c, made at created.
+func Render(c pio.ConfigFile, created time.Time) string {
+	doc := header
+	if c.Keyfile != "" {
+		doc += "The vault also needs its keyfile, which is not part of this backup.\n\n"
+	}
+	return doc + "Created " + created.Format("2006-01-02") + "\n\n" + Fields(c)
+}
+
+// Parse reads back the fields of a backup made by Render, or the contents
+// of its QR code from QRText. Case and spacing do not matter, other than
+// in the keyfile path, so it can be typed in.
+func Parse(text string) (c pio.ConfigFile, err error) {
+	fields := map[string]string{}
+	name := ""
+	s := bufio.NewScanner(strings.NewReader(text))
+	for s.Scan() {
+		line := s.Text()
+		i := strings.Index(line, ":")
+		switch {
+		case strings.TrimSpace(line) == "":
+			name = ""
+		case i > 0 && !strings.ContainsAny(line[:i], " \t"):
+			name = strings.ToLower(line[:i])
+			fields[name] = strings.TrimSpace(line[i+1:])
+		case name != "":
+			// Long values continue on the following lines.
+			fields[name] += " " + strings.TrimSpace(line)
+		}
+	}
+	if fields["kdf"] == "" {
+		return c, errors.New("No backup was found")
+	}
+	if !strings.EqualFold(fields["kdf"], kdf) {
+		return c, fmt.Errorf("Unsupported kdf %s", fields["kdf"])
+	}
+	pub, err := parseHex(fields, "public-key", 32)
+	if err != nil {
+		return c, err
+	}
+	salt, err := parseHex(fields, "password-salt", 32)
+	if err != nil {
+		return c, err
+	}
+	if c.MasterKeyPrivSealed, err = parseHex(fields, "sealed-private-key", sealedKeyLen); err != nil {
+		return c, err
+	}
+	c.Keyfile = fields["keyfile"]
+	copy(c.MasterPubKey[:], pub)
+	copy(c.MasterPassKeySalt[:], salt)
+	sum, err := parseHex(fields, "checksum", len(checksum(c)))
+	if err != nil {
+		return c, err
+	}
+	if !bytes.Equal(sum, checksum(c)) {
+		return c, errors.New("The checksum does not match, there is a typo in the backup")
+	}
+	return c, nil
+}
+
+// Backup prints the paper backup of the vault, or writes it to outPath.
+// If qrPath is set, a QR code of it is written there too.
+func Backup(outPath, qrPath string) {
+	c, err := pio.ReadConfig()
+	if err != nil {
+		log.Fatalf("Could not read config file: %s", err.Error())
+	}
+	doc := Render(c, time.Now())
+	if outPath == "" {
+		fmt.Print(doc)
+	} else if err = ioutil.WriteFile(outPath, []byte(doc), 0600); err != nil {
+		log.Fatalf("Could not write backup: %s", err.Error())
+	}
+	if qrPath != "" {
+		if err = WriteQR(qrPath, QRText(c)); err != nil {
+			log.Fatalf("Could not write QR code: %s", err.Error())
+		}
+	}
+}
+
+// Restore rebuilds the config file of the vault from the backup at path,
+// once the master password is shown to unlock it. An existing config file
+// is only replaced if force is set.
+func Restore(path string, force bool) {
+	text, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Could not read backup: %s", err.Error())
+	}
+	c, err := Parse(string(text))
+	if err != nil {
+		log.Fatalf("Could not read backup: %s", err.Error())
+	}
+	if err = checkPassword(c); err != nil {
+		log.Fatalf("Could not restore backup: %s", err.Error())
+	}
+
+	passDir, err := pio.GetPassDir()
+	if err != nil {
+		log.Fatalf("Could not get pass dir: %s", err.Error())
+	}
+	if err = os.MkdirAll(passDir, 0700); err != nil {
+		log.Fatalf("Could not create passgo vault: %s", err.Error())
+	}
+	configPath, err := pio.GetConfigPath()
+	if err != nil {
+		log.Fatalf("Could not get pass config: %s", err.Error())
+	}
+	flags := os.O_RDWR | os.O_CREATE | os.O_EXCL
+	if force {
+		flags = os.O_RDWR | os.O_CREATE | os.O_TRUNC
+	}
+	config, err := os.OpenFile(configPath, flags, 0600)
+	if os.IsExist(err) {
+		log.Fatalf("A passgo config file was already found. Use --force to replace it.")
+	}
+	if err != nil {
+		log.Fatalf("Could not create passgo config: %s", err.Error())
+	}
+	config.Close()
+	if err = c.SaveFile(); err != nil {
+		log.Fatalf("Could not write to config file: %s", err.Error())
+	}
+	fmt.Printf("Restored %s\n", configPath)
+	if sitesFile, err := pio.GetSitesFile(); err == nil && !fileExists(sitesFile) {
+		fmt.Println("Copy sites.json and the files directory of the vault next to it to get your passwords back.")
+	}
+}
+
+// checkPassword prompts for the master password, and checks that it
+// unlocks the private key of c.
+func checkPassword(c pio.ConfigFile) error {
+	pass, err := pio.PromptMasterPass(pio.MasterPassPrompt)
+	if err != nil {
+		return err
+	}
+	_, err = pc.OpenMasterKey(pass, &c)
+	return err
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func checksum(c pio.ConfigFile) []byte {
+	h := sha256.New()
+	h.Write([]byte(kdf))
+	h.Write([]byte(c.Keyfile))
+	h.Write(c.MasterPubKey[:])
+	h.Write(c.MasterPassKeySalt[:])
+	h.Write(c.MasterKeyPrivSealed)
+	return h.Sum(nil)[:4]
+}
+
+// writeField writes name and the groups of its value, wrapped on to
+// indented lines every lineGroups groups.
+func writeField(b *strings.Builder, name string, groups []string) {
+	prefix := name + ": "
+	for len(groups) > 0 {
+		n := lineGroups
+		if n > len(groups) {
+			n = len(groups)
+		}
+		b.WriteString(prefix + strings.Join(groups[:n], " ") + "\n")
+		groups = groups[n:]
+		prefix = strings.Repeat(" ", len(prefix))
+	}
+}
+
+// groupHex returns b in hex, split in to groups of groupLen digits.
+func groupHex(b []byte) []string {
+	h := hex.EncodeToString(b)
+	var groups []string
+	for len(h) > groupLen {
+		groups = append(groups, h[:groupLen])
+		h = h[groupLen:]
+	}
+	return append(groups, h)
+}
+
+func parseHex(fields map[string]string, name string, n int) ([]byte, error) {
+	value, ok := fields[name]
+	if !ok {
+		return nil, fmt.Errorf("The backup has no %s", name)
+	}
+	b, err := hex.DecodeString(strings.Join(strings.Fields(value), ""))
+	if err != nil || len(b) != n {
+		return nil, fmt.Errorf("The %s is not %d bytes of hex, there is a typo in the backup", name, n)
+	}
+	return b, nil
+}
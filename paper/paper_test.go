@@ -0,0 +1,111 @@
+package paper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ejcx/passgo/v2/internal/vaulttest"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+func TestRenderParse(t *testing.T) {
+	_, cleanup := vaulttest.New(t)
+	defer cleanup()
+	c, err := pio.ReadConfig()
+	if err != nil {
+		t.Fatalf("Could not read config: %s", err)
+	}
+
+	doc := Render(c, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+	if !strings.Contains(doc, "Created 2020-01-02\n") || !strings.HasSuffix(doc, Fields(c)) {
+		t.Errorf("Unexpected backup:\n%s", doc)
+	}
+	lines := strings.Split(Fields(c), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+	}
+	unindented := strings.Join(lines, "\n")
+	for _, text := range []string{doc, Fields(c), strings.ToUpper(Fields(c)), unindented, QRText(c)} {
+		got, err := Parse(text)
+		if err != nil {
+			t.Errorf("Could not parse backup: %s\n%s", err, text)
+			continue
+		}
+		if got.MasterPubKey != c.MasterPubKey || got.MasterPassKeySalt != c.MasterPassKeySalt || !bytes.Equal(got.MasterKeyPrivSealed, c.MasterKeyPrivSealed) {
+			t.Errorf("Parsed backup does not match the config")
+		}
+	}
+
+	keyed := c
+	keyed.Keyfile = "/media/USB/passgo key"
+	for _, text := range []string{Render(keyed, time.Now()), QRText(keyed)} {
+		if got, err := Parse(text); err != nil || got.Keyfile != keyed.Keyfile {
+			t.Errorf("Parsed keyfile %q, %v", got.Keyfile, err)
+		}
+	}
+	if _, err := Parse(strings.Replace(Fields(keyed), "USB", "usb", 1)); err == nil {
+		t.Errorf("Parsed a backup with a changed keyfile")
+	}
+
+	lines = strings.Split(Fields(c), "\n")
+	typo := []byte(lines[3])
+	if typo[len(typo)-1] == '0' {
+		typo[len(typo)-1] = '1'
+	} else {
+		typo[len(typo)-1] = '0'
+	}
+	lines[3] = string(typo)
+	for _, text := range []string{
+		strings.Join(lines, "\n"),
+		strings.Replace(Fields(c), "N=262144", "N=16384", 1),
+		strings.Replace(Fields(c), "public-key", "publickey", 1),
+		"passgo paper backup\n",
+	} {
+		if _, err := Parse(text); err == nil {
+			t.Errorf("Parsed a broken backup:\n%s", text)
+		}
+	}
+}
+
+func TestRestore(t *testing.T) {
+	dir, cleanup := vaulttest.New(t)
+	defer cleanup()
+	key := vaulttest.Unlock()
+
+	backup := filepath.Join(dir, "backup.txt")
+	Backup(backup, "")
+	configPath, _ := pio.GetConfigPath()
+	os.Remove(configPath)
+
+	pio.Input = pio.NewStreamPrompter(strings.NewReader(vaulttest.MasterPassword+"\n"), ioutil.Discard)
+	Restore(backup, false)
+	if got := vaulttest.Unlock(); got != key {
+		t.Errorf("Restored config unlocks a different key")
+	}
+
+	c, _ := pio.ReadConfig()
+	pio.Input = pio.NewStreamPrompter(strings.NewReader("wrong\n"), ioutil.Discard)
+	if err := checkPassword(c); err == nil {
+		t.Errorf("Wrong master password was accepted")
+	}
+}
+
+func TestWriteQR(t *testing.T) {
+	dir, cleanup := vaulttest.New(t)
+	defer cleanup()
+	for name, magic := range map[string]string{"backup.png": "\x89PNG", "backup.SVG": "<svg "} {
+		path := filepath.Join(dir, name)
+		if err := WriteQR(path, "kdf: scrypt N=262144 r=8 p=1\n"); err != nil {
+			t.Fatalf("Could not write %s: %s", name, err)
+		}
+		b, _ := ioutil.ReadFile(path)
+		if !bytes.HasPrefix(b, []byte(magic)) {
+			t.Errorf("%s starts with %q", name, b[:8])
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package paper
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// modulePixels is the size of a QR code module in PNG images.
+const modulePixels = 8
+
+// WriteQR writes a QR code of text to path, as an SVG image if path ends
+// in .svg and as a PNG otherwise.
+func WriteQR(path, text string) error {
+	code, err := qrcode.New(text, qrcode.Medium)
+	if err != nil {
+		return err
+	}
+	var img []byte
+	if strings.EqualFold(filepath.Ext(path), ".svg") {
+		img = svg(code.Bitmap())
+	} else if img, err = code.PNG(-modulePixels); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, img, 0600)
+}
+
+// svg draws bitmap, which includes the quiet zone, with one square per
+// black module.
+func svg(bitmap [][]bool) []byte {
+	var b strings.Builder
+	size := len(bitmap)
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" width="%dmm" height="%dmm" shape-rendering="crispEdges">`+"\n", size, size, size, size)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/>`+"\n", size, size)
+	b.WriteString(`<path fill="#000" d="`)
+	for y, row := range bitmap {
+		for x, black := range row {
+			if black {
+				fmt.Fprintf(&b, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	b.WriteString("\"/>\n</svg>\n")
+	return []byte(b.String())
+}
@@ -2,30 +2,88 @@ package main
 
 import (
 	"fmt"
+	"log"
+	"os"
 	"runtime/debug"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ejcx/passgo/v2/audit"
 	"github.com/ejcx/passgo/v2/edit"
+	"github.com/ejcx/passgo/v2/environ"
+	"github.com/ejcx/passgo/v2/format"
 	"github.com/ejcx/passgo/v2/generate"
 	"github.com/ejcx/passgo/v2/initialize"
+	"github.com/ejcx/passgo/v2/inject"
 	"github.com/ejcx/passgo/v2/insert"
+	"github.com/ejcx/passgo/v2/keyfile"
+	"github.com/ejcx/passgo/v2/mount"
+	"github.com/ejcx/passgo/v2/nativehost"
+	"github.com/ejcx/passgo/v2/paper"
+	"github.com/ejcx/passgo/v2/pc"
 	"github.com/ejcx/passgo/v2/pio"
+	"github.com/ejcx/passgo/v2/recovery"
+	"github.com/ejcx/passgo/v2/serve"
 	"github.com/ejcx/passgo/v2/show"
+	"github.com/ejcx/passgo/v2/sshkey"
+	"github.com/ejcx/passgo/v2/stats"
+	"github.com/ejcx/passgo/v2/tui"
 	"github.com/spf13/cobra"
 )
 
 var (
-	copyPass bool
-	RootCmd  = &cobra.Command{
+	copyPass     bool
+	multiline    bool
+	useEditor    bool
+	outputPath   string
+	overwrite    bool
+	readStdin    bool
+	passFile     string
+	masterPassFD int
+	printPass    bool
+	inPlace      bool
+	weakOnly     bool
+	phraseSpecs  pc.PassphraseSpecs
+	wordlistPath string
+	pwSpecs      = generate.DefaultSpecs()
+	policyName   string
+	savePolicy   string
+	compression  string
+	recursive    bool
+	sortBy       string
+	formatName   string
+	siteMeta     pio.Metadata
+	findOpts     show.FindOptions
+	envSpecs     []string
+	inputPath    string
+	checkOnly    bool
+	lockAfter    time.Duration
+	confirmUse   bool
+	socketPath   string
+	agentSocket  string
+	serveAddr    string
+	tokenFile    string
+	noPrompt     bool
+	findSort     string
+	shareCount   int
+	threshold    int
+	qrShares     bool
+	qrPath       string
+	keyfilePath  string
+	RootCmd      = &cobra.Command{
 		Use:   "passgo",
 		Short: "Print the contents of the vault.",
 		Long: `Print the contents of the vault. If you have
 not yet initialized your vault, it is necessary to run
 the init subcommand in order to create your passgo
 directory, and initialize your cryptographic keys.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			setInputs()
+		},
 		Run: func(cmd *cobra.Command, args []string) {
 			if exists, _ := pio.PassFileDirExists(); exists {
-				show.ListAll()
+				show.ListAll(sortOrder(), outputFormat())
 			} else {
 				cmd.Help()
 			}
@@ -46,50 +104,161 @@ directory, and initialize your cryptographic keys.`,
 	initCmd = &cobra.Command{
 		Use:   "init",
 		Short: "Initialize your passgo vault",
-		Long:  "Initialize the .passgo directory, and generate your secret keys",
+		Long: `Initialize the .passgo directory, and generate your secret keys.
+
+With --keyfile, the vault also needs the file at that path to unlock. It
+is created with random contents if it does not exist.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			initialize.InitWithKeyfile(keyfilePath)
+		},
+	}
+	injectCmd = &cobra.Command{
+		Use:     "inject",
+		Short:   "Fill in a template with passwords from the vault.",
+		Example: "passgo inject -i config.tmpl -o config.yaml\npassgo inject --check -i config.tmpl",
+		Long: `Render a template, replacing references to sites with their contents:
+
+  {{ passgo "db/prod" "password" }}
+
+The field is one of password, the first line of the site, contents, all of
+it, username or url. It defaults to password.
+
+The template is read from -i or stdin, and written to -o or stdout. Output
+files are only readable by you, and are left alone if a site is missing.
+Use --check to make sure every referenced site exists without unlocking
+the vault.`,
+		Args: cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
-			initialize.Init()
+			if err := inject.Inject(inputPath, outputPath, checkOnly); err != nil {
+				log.Fatalf("Could not inject: %s", err.Error())
+			}
 		},
 	}
 	insertCmd = &cobra.Command{
 		Use:     "insert",
 		Short:   "Insert a file or password in to your vault",
-		Example: "passgo insert money/bank.com",
+		Example: "passgo insert money/bank.com\necho hunter2 | passgo insert --stdin money/bank.com\npassgo insert --recursive certs/prod ./prod-certs/",
 		Args:    cobra.RangeArgs(1, 2),
 		Long: `Add a site to your password store. This site can optionally be a part
 of a group by prepending a group name and slash to the site name.
-Will prompt for confirmation when a site path is not unique.`,
+Will prompt for confirmation when a site path is not unique.
+
+Use -m to store several lines, for example a password followed by notes.
+The contents are read until EOF.
+
+Files can be compressed before they are encrypted with --compress, which
+saves space for text such as CSVs, configs and database dumps.
+
+Use -r to insert every file in a directory as its own file entry, named by
+its path inside the directory. Existing entries are skipped unless --force
+is used.`,
 		Run: func(cmd *cobra.Command, args []string) {
-			if len(args) == 2 {
+			if err := pc.CheckCompression(compression); err != nil {
+				log.Fatalf("Could not insert: %s", err.Error())
+			}
+			if compression != pio.CompressionNone && len(args) != 2 {
+				log.Fatalf("Could not insert: --compress can only be used when inserting a file")
+			}
+			if recursive {
+				if len(args) != 2 {
+					log.Fatalf("Could not insert: --recursive needs a group and a directory")
+				}
+				insert.Recursive(args[0], args[1], compression, overwrite, siteMeta)
+			} else if len(args) == 2 {
 				path := args[0]
 				filename := args[1]
-				insert.File(path, filename)
+				insert.File(path, filename, compression, siteMeta)
+			} else if multiline {
+				insert.Multiline(args[0], siteMeta)
 			} else {
 				pathName := args[0]
-				insert.Password(pathName)
+				insert.Password(pathName, siteMeta)
 			}
 		},
 	}
 	showCmd = &cobra.Command{
 		Use:     "show",
-		Example: "passgo show money/bank.com",
+		Example: "passgo show money/bank.com\npassgo show money/budget.csv --output budget.csv",
 		Short:   "Print the password of a passgo entry.",
 		Args:    cobra.ExactArgs(1),
+		Long: `Print the password of a passgo entry, or the contents of a file entry.
+Binary files are never printed to a terminal. Use --output to decrypt them
+to a file instead, which keeps the permissions the file was inserted with.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			path := args[0]
-			show.Site(path, copyPass)
+			if outputPath != "" {
+				show.SiteToFile(path, outputPath)
+				return
+			}
+			show.Site(path, copyPass, outputFormat())
+		},
+	}
+	execCmd = &cobra.Command{
+		Use:     "exec",
+		Short:   "Run a command with passwords in its environment.",
+		Example: "passgo exec --env AWS_SECRET=work/aws/secret --env DB_PASS=db/prod -- ./deploy.sh",
+		Long: `Unlock the vault once and run a command with each --env NAME=site
+variable set to the contents of the site, without the passwords being
+written to disk or to your shell history. Trailing newlines are removed,
+like $(passgo show site) would.`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			vars, err := environ.ParseVars(envSpecs)
+			if err != nil {
+				log.Fatalf("Could not parse --env: %s", err.Error())
+			}
+			if err = environ.Exec(vars, args, os.Environ()); err != nil {
+				log.Fatalf("Could not run %s: %s", args[0], err.Error())
+			}
+		},
+	}
+	extractCmd = &cobra.Command{
+		Use:     "extract",
+		Example: "passgo extract money ./money\npassgo extract certs/prod ./out",
+		Short:   "Decrypt every file entry in a group to a directory.",
+		Long: `Decrypt every file entry in a group and write it to a directory. Files
+are written with the name and permissions they had when they were inserted,
+and entries in nested groups are written to matching subdirectories.
+Existing files are not replaced unless --force is used.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			show.Extract(args[0], args[1], overwrite)
 		},
 	}
 	generateCmd = &cobra.Command{
-		Use:     "generate",
-		Short:   "Generate a secure password",
-		Example: "passgo generate",
+		Use:   "generate",
+		Short: "Generate a secure password",
+		Example: `passgo generate
+passgo generate --words 6 --separator - --capitalize --digit
+passgo generate 16 --charset a-zA-Z0-9 --min-digits 2 --save-policy bank
+passgo generate --policy bank
+passgo generate money/bank.com 32 --copy`,
 		Long: `Prints a randomly generated password. The length of this password defaults
-to 24. If a password length is specified as greater than 2048 then generate
-will fail.`,
-		Args: cobra.RangeArgs(0, 1),
+to 24. Passwords can be up to 1048576 characters long, which is long enough
+to be used as a key for encrypting files.
+
+When --words or --wordlist is used a diceware style passphrase is printed
+instead, and its entropy in bits is reported on stderr. Words are chosen from
+the EFF long wordlist unless a custom wordlist file is given.
+
+The characters used in passwords can be restricted with --charset, --exclude
+and --no-ambiguous, and the number of characters of each class with the
+--min-* and --max-* flags. Rules can be saved as a named policy with
+--save-policy and reused with --policy.
+
+If a site path is given the generated password is stored in the vault
+instead of being printed. Use --print or --copy to see it, and --in-place
+to replace the password of a site that already exists.`,
+		Args: cobra.RangeArgs(0, 2),
 		Run: func(cmd *cobra.Command, args []string) {
+			var path string
 			pwlen := -1
+			if len(args) != 0 {
+				if _, err := strconv.Atoi(args[0]); err != nil {
+					path = args[0]
+					args = args[1:]
+				}
+			}
 			if len(args) != 0 {
 				pwlenStr := args[0]
 				pwlenint, err := strconv.Atoi(pwlenStr)
@@ -99,28 +268,96 @@ will fail.`,
 					pwlen = pwlenint
 				}
 			}
-			pass := generate.Generate(pwlen)
-			fmt.Println(pass)
+			var pass string
+			if phraseSpecs.Words > 0 || wordlistPath != "" {
+				var bits float64
+				pass, bits = generate.Passphrase(&phraseSpecs, wordlistPath)
+				defer fmt.Fprintf(os.Stderr, "Entropy: %.1f bits\n", bits)
+			} else if policyName != "" {
+				pass = generate.Policy(policyName, pwlen)
+			} else {
+				if savePolicy != "" {
+					generate.SavePolicy(savePolicy, pwSpecs, pwlen)
+				}
+				pass = generate.WithSpecs(pwSpecs, pwlen)
+			}
+			if path == "" {
+				fmt.Println(pass)
+				return
+			}
+			if inPlace {
+				if err := edit.Replace(path, pass); err != nil {
+					log.Fatalf("Could not change the password of %s: %s", path, err.Error())
+				}
+			} else if err := insert.SealPassword(path, pass, siteMeta); err != nil {
+				log.Fatalf("Could not add %s: %s", path, err.Error())
+			}
+			if copyPass {
+				pio.ToClipboard(pass)
+			}
+			if printPass {
+				fmt.Println(pass)
+			} else {
+				fmt.Fprintf(os.Stderr, "Generated password for %s\n", path)
+			}
 		},
 	}
 	findCmd = &cobra.Command{
 		Use:     "find",
-		Aliases: []string{"ls"},
-		Example: "passgo find bank.com",
+		Example: "passgo find bank.com\npassgo find mnt\npassgo find --regex '^work/.*prod$'",
 		Short:   "Find a site that contains the site-path.",
-		Long: `Prints all sites that contain the site-path. Used to print just
-one group or all sites that contain a certain word in the group or name`,
+		Long: `Prints all sites that match the site-path. The letters of the site-path
+have to appear in the path of a site in order, but not next to each other,
+so "mnt" finds money/mint.com. Sites whose URL, username or tags contain
+the site-path are printed too. The best matches are listed first.
+
+Searches ignore case unless --case-sensitive is used. Use --regex to
+search with a regular expression instead.`,
 		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			path := args[0]
-			show.Find(path)
+			order, err := show.ParseSortOrder(findSort)
+			if err != nil {
+				log.Fatalf("Could not list sites: %s", err.Error())
+			}
+			show.Find(path, findOpts, order, outputFormat())
+		},
+	}
+	infoCmd = &cobra.Command{
+		Use:     "info",
+		Example: "passgo info money/bank.com\npassgo info --format json money/budget.csv",
+		Short:   "Print what is known about a site without decrypting it.",
+		Long: `Print the group, type, creation and modification times of a site, and
+the name, permissions and size of file entries. The master password is not
+needed.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			show.Info(args[0], outputFormat())
+		},
+	}
+	lsCmd = &cobra.Command{
+		Use:     "ls",
+		Aliases: []string{"list"},
+		Example: "passgo ls\npassgo ls work/aws",
+		Short:   "List the sites in the vault or in a group.",
+		Long: `Prints the sites in the vault as a tree. When a group is given only the
+sites in that group and its subgroups are printed.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				show.ListAll(sortOrder(), outputFormat())
+				return
+			}
+			show.List(args[0], sortOrder(), outputFormat())
 		},
 	}
 	renameCmd = &cobra.Command{
 		Use:     "rename",
 		Short:   "Rename an entry in the password vault",
-		Example: "passgo rename money/bank.com",
-		Args:    cobra.ExactArgs(1),
+		Example: "passgo rename money/bank.com\npassgo rename work/aws",
+		Long: `Rename an entry in the password vault. When the path is a group, every
+site in the group and its subgroups is moved to the new group.`,
+		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			path := args[0]
 			edit.Rename(path)
@@ -130,36 +367,437 @@ one group or all sites that contain a certain word in the group or name`,
 		Use:     "edit",
 		Aliases: []string{"update"},
 		Short:   "Change the password of a site in the vault.",
-		Example: "passgo edit money/bank.com",
-		Args:    cobra.ExactArgs(1),
+		Example: "passgo edit money/bank.com\npassgo edit -e money/bank.com",
+		Long: `Change the password of a site in the vault. New keys are always
+generated for the edited site.
+
+With -e the decrypted site, or file entry, is opened in $EDITOR instead. It
+is written to a temporary file that only you can read, in /dev/shm when it
+is available, which is shredded once the editor exits.`,
+		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			path := args[0]
+			if useEditor {
+				edit.Editor(path)
+				return
+			}
 			edit.Edit(path)
 		},
 	}
+	auditCmd = &cobra.Command{
+		Use:     "audit",
+		Short:   "Report the strength of every password in the vault.",
+		Example: "passgo audit --weak",
+		Long: `Decrypts every password in the vault and estimates how many guesses it
+would take to crack it. Dictionary words, keyboard walks, dates, repeats,
+sequences and l33t substitutions are all taken in to account.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			audit.Audit(weakOnly, outputFormat())
+		},
+	}
+	keyfileCmd = &cobra.Command{
+		Use:   "keyfile",
+		Short: "Require a keyfile as well as the master password.",
+		Long: `A keyfile kept out of the vault directory means that a published vault
+and a guessed master password are not enough to unlock it. The path of
+the keyfile is stored in the config file, and $PASSGO_KEYFILE overrides
+it.`,
+	}
+	keyfileSetCmd = &cobra.Command{
+		Use:     "set",
+		Short:   "Make the vault need a keyfile, creating it if it does not exist.",
+		Example: "passgo keyfile set /media/usb/passgo.key",
+		Long: `Make the vault need a keyfile, creating it if it does not exist. Every
+site is encrypted again with a new master key, so that old copies of the
+config can not unlock the vault without the keyfile. Paper backups and
+recovery shares made before no longer work afterwards.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			keyfile.Set(args[0])
+		},
+	}
+	keyfileRemoveCmd = &cobra.Command{
+		Use:   "remove",
+		Short: "Make the vault need only the master password.",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			keyfile.Remove()
+		},
+	}
+	mountCmd = &cobra.Command{
+		Use:     "mount",
+		Short:   "Mount the vault as a read-only filesystem.",
+		Example: "passgo mount ~/secrets\ncat ~/secrets/money/bank.com",
+		Long: `Unlock the vault and mount it on a directory, with groups as directories
+and sites as files that are decrypted when they are read. Files are only
+readable by you, and nothing can be written. Sites over 64MB can not be
+read from the mount, use passgo show --output for those.
+
+The vault is locked and unmounted when passgo is interrupted, or when
+nothing has been read for --timeout. Use --timeout 0 to stay mounted.
+Needs FUSE, which is available on Linux, macOS and FreeBSD.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := mount.Mount(args[0], lockAfter); err != nil {
+				log.Fatalf("Could not mount the vault: %s", err.Error())
+			}
+		},
+	}
+	nativeHostCmd = &cobra.Command{
+		Use:   "native-host",
+		Short: "Answer autofill requests from a browser extension.",
+		Long: `Speaks the Chrome and Firefox native messaging protocol on stdin and
+stdout, and is started by the browser rather than by hand.
+
+Credentials are read from passgo serve on the socket in $PASSGO_SOCKET, or
+passgo.sock in $XDG_RUNTIME_DIR, so the vault has to be unlocked with
+passgo serve --socket first, and every password the extension reads has to
+be approved there.`,
+		// Browsers pass the extension's origin and other arguments that
+		// are not passgo flags.
+		DisableFlagParsing: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			c := serve.NewClient(serve.DefaultSocket())
+			c.UserAgent = "passgo native-host"
+			for _, arg := range args {
+				if !strings.HasPrefix(arg, "--") {
+					c.UserAgent = "passgo native-host for " + arg
+				}
+			}
+			if err := nativehost.Run(os.Stdin, os.Stdout, c); err != nil {
+				log.Fatalf("Could not answer browser: %s", err.Error())
+			}
+		},
+	}
+	serveCmd = &cobra.Command{
+		Use:     "serve",
+		Short:   "Serve the vault to local tools over HTTP.",
+		Example: "passgo serve --socket /run/user/1000/passgo.sock\ncurl --unix-socket /run/user/1000/passgo.sock http://passgo/sites/money/bank.com",
+		Long: `Unlocks the vault and answers JSON requests to list, get, insert and edit
+sites:
+
+  GET  /sites?group=g   list the sites in the vault, or in group g
+  GET  /sites/<name>    get a site along with its password
+  POST /sites           add a password: {"name", "password", "url", "username", "tags"}
+  PUT  /sites/<name>    change the password of a site: {"password"}
+
+With --socket the vault is served on a Unix socket only the current user
+can connect to. Otherwise it is served on a loopback address, and clients
+must send "Authorization: Bearer <token>" using the token in --token-file,
+or the one printed when the server starts.
+
+Every password that is read has to be approved on the terminal, unless
+--no-prompt is used.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if socketPath != "" && cmd.Flags().Changed("addr") {
+				log.Fatalf("Only one of --socket and --addr can be used")
+			}
+			if err := serve.Serve(socketPath, serveAddr, tokenFile, !noPrompt); err != nil {
+				log.Fatalf("Could not serve the vault: %s", err.Error())
+			}
+		},
+	}
+	tuiCmd = &cobra.Command{
+		Use:   "tui",
+		Short: "Browse and change the vault in a full-screen terminal interface.",
+		Long: `Unlocks the vault and shows it as a tree that is filtered as you type.
+Passwords can be revealed and copied, and sites added, edited, renamed and
+removed, using the keys listed at the bottom of the screen.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := tui.Start(); err != nil {
+				log.Fatalf("Could not start terminal interface: %s", err.Error())
+			}
+		},
+	}
+	sshKeyCmd = &cobra.Command{
+		Use:   "ssh-key",
+		Short: "Store SSH private keys in the vault.",
+		Long: `SSH key entries hold an OpenSSH private key, and can be used by
+passgo ssh-agent. Their public key is stored unencrypted, so it can be
+printed without the master password.`,
+	}
+	sshKeyGenerateCmd = &cobra.Command{
+		Use:     "generate",
+		Short:   "Generate a new ed25519 key in the vault.",
+		Example: "passgo ssh-key generate ssh/github",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			pub, err := sshkey.Generate(args[0], siteMeta)
+			if err != nil {
+				log.Fatalf("Could not generate SSH key: %s", err.Error())
+			}
+			fmt.Println(pub)
+		},
+	}
+	sshKeyImportCmd = &cobra.Command{
+		Use:     "import",
+		Short:   "Import an OpenSSH private key in to the vault.",
+		Example: "passgo ssh-key import ssh/work ~/.ssh/id_ed25519",
+		Long: `Import an RSA, ECDSA or ed25519 private key. Keys protected by a
+passphrase must have it removed from a copy with ssh-keygen -p first.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			pub, err := sshkey.Import(args[0], args[1], siteMeta)
+			if err != nil {
+				log.Fatalf("Could not import SSH key: %s", err.Error())
+			}
+			fmt.Println(pub)
+		},
+	}
+	sshKeyPublicCmd = &cobra.Command{
+		Use:     "public",
+		Short:   "Print the public key of an SSH key entry.",
+		Example: "passgo ssh-key public ssh/github >> ~/.ssh/authorized_keys",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			pub, err := sshkey.PublicKey(args[0])
+			if err != nil {
+				log.Fatalf("Could not get public key: %s", err.Error())
+			}
+			fmt.Println(pub)
+		},
+	}
+	sshAgentCmd = &cobra.Command{
+		Use:     "ssh-agent",
+		Short:   "Run an ssh-agent for the SSH keys in the vault.",
+		Example: "passgo ssh-agent\n\n# In another terminal:\nexport SSH_AUTH_SOCK=$XDG_RUNTIME_DIR/passgo-agent.sock\nssh git@github.com",
+		Long: `Unlock the vault and serve its SSH key entries to ssh over a Unix socket
+until passgo is interrupted. Keys are only decrypted while signing. Use
+--confirm to approve every signature on the terminal.
+
+The agent runs in the foreground, so that it can ask for the master
+password and for approval. Point SSH_AUTH_SOCK at its socket in the
+shells that use it. The line to do so is printed when the agent starts.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := sshkey.Serve(agentSocket, confirmUse); err != nil {
+				log.Fatalf("Could not run ssh-agent: %s", err.Error())
+			}
+		},
+	}
+	statsCmd = &cobra.Command{
+		Use:   "stats",
+		Short: "Show how much space file entries take up.",
+		Long: `Print the original and stored size of every file entry in the vault,
+and how well it compressed.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			stats.Stats()
+		},
+	}
+	paperBackupCmd = &cobra.Command{
+		Use:     "paper-backup",
+		Short:   "Print a paper backup of the master key.",
+		Example: "passgo paper-backup -o backup.txt --qr backup.png",
+		Long: `Render the sealed master private key, its salt and the KDF parameters
+as a document to print. With sites.json and the files directory, such as
+from a vault published in git, it recovers the vault after every device
+it was on is lost. It is useless without the master password.
+
+--qr also writes a QR code of it, as an SVG if the path ends in .svg and
+a PNG otherwise.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			paper.Backup(outputPath, qrPath)
+		},
+	}
+	paperRestoreCmd = &cobra.Command{
+		Use:     "paper-restore",
+		Short:   "Rebuild the config file from a paper backup.",
+		Example: "passgo paper-restore backup.txt",
+		Long: `Read a paper backup, typed in or scanned from its QR code, check that
+the master password unlocks it, and write it as the config file of the
+vault.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			paper.Restore(args[0], overwrite)
+		},
+	}
+	recoveryCmd = &cobra.Command{
+		Use:   "recovery",
+		Short: "Recover the vault if the master password is forgotten.",
+		Long: `Split the master private key in to shares to give to people you trust,
+so that enough of them together can set a new master password. Fewer
+shares than the threshold reveal nothing about the key.`,
+	}
+	recoverySplitCmd = &cobra.Command{
+		Use:     "split",
+		Short:   "Split the master key in to recovery shares.",
+		Example: "passgo recovery split --shares 5 --threshold 3",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			recovery.Split(shareCount, threshold, qrShares)
+		},
+	}
+	recoveryCombineCmd = &cobra.Command{
+		Use:   "combine",
+		Short: "Recover the master key from shares and set a new master password.",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			recovery.Combine()
+		},
+	}
 	removeCmd = &cobra.Command{
 		Use:     "remove",
 		Aliases: []string{"rm"},
-		Example: "passgo remove money/bank.com",
+		Example: "passgo remove money/bank.com\npassgo remove -r work/aws",
 		Short:   "Remove a site from the password vault by specifying the entire site-path.",
 		Args:    cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			path := args[0]
+			if recursive {
+				edit.RemoveGroup(path)
+				return
+			}
 			edit.RemovePassword(path)
 		},
 	}
 )
 
+// sortOrder returns the order that listings are printed in.
+func sortOrder() show.SortOrder {
+	order, err := show.ParseSortOrder(sortBy)
+	if err != nil {
+		log.Fatalf("Could not list sites: %s", err.Error())
+	}
+	return order
+}
+
+// outputFormat returns the format that results are printed in.
+func outputFormat() format.Format {
+	f, err := format.Parse(formatName)
+	if err != nil {
+		log.Fatalf("Could not print results: %s", err.Error())
+	}
+	return f
+}
+
+// setInputs configures where secrets are read from when passgo is not
+// used interactively.
+func setInputs() {
+	if readStdin && passFile != "" {
+		log.Fatalf("Only one of --stdin and --password-file can be used")
+	}
+	if readStdin {
+		pio.SetSecretInput(os.Stdin)
+	}
+	if passFile != "" {
+		f, err := os.Open(passFile)
+		if err != nil {
+			log.Fatalf("Could not open password file: %s", err.Error())
+		}
+		pio.SetSecretInput(f)
+	}
+	if masterPassFD >= 0 {
+		if err := pio.SetMasterPassFD(masterPassFD); err != nil {
+			log.Fatalf("Could not use master password fd: %s", err.Error())
+		}
+	}
+}
+
 func init() {
+	RootCmd.PersistentFlags().BoolVar(&readStdin, "stdin", false, "Read passwords and secrets from stdin instead of prompting")
+	RootCmd.PersistentFlags().StringVar(&passFile, "password-file", "", "Read passwords and secrets from a file instead of prompting")
+	RootCmd.PersistentFlags().StringVar(&formatName, "format", "plain", "Print results as plain, json or yaml")
+	RootCmd.PersistentFlags().IntVar(&masterPassFD, "master-password-fd", -1, "Read the master password from an open file descriptor")
+	editCmd.Flags().BoolVarP(&useEditor, "editor", "e", false, "Edit the site in $EDITOR")
+	initCmd.Flags().StringVar(&keyfilePath, "keyfile", "", "Also need this file to unlock the vault")
+	injectCmd.Flags().StringVarP(&inputPath, "input", "i", "", "Template to read instead of stdin")
+	injectCmd.Flags().StringVarP(&outputPath, "output", "o", "", "File to write instead of stdout")
+	injectCmd.Flags().BoolVar(&checkOnly, "check", false, "Only check that every referenced site exists")
+	insertCmd.Flags().BoolVarP(&multiline, "multiline", "m", false, "Insert a secret that spans multiple lines")
+	insertCmd.Flags().StringVarP(&compression, "compress", "z", pio.CompressionNone, "Compress a file before encrypting it (gzip)")
+	insertCmd.Flags().Lookup("compress").NoOptDefVal = pio.CompressionGzip
+	for _, cmd := range []*cobra.Command{insertCmd, generateCmd, sshKeyGenerateCmd, sshKeyImportCmd} {
+		cmd.Flags().StringVar(&siteMeta.URL, "url", "", "URL of the site, stored unencrypted")
+		cmd.Flags().StringVar(&siteMeta.Username, "username", "", "Username for the site, stored unencrypted")
+		cmd.Flags().StringSliceVar(&siteMeta.Tags, "tag", nil, "Tag the site, stored unencrypted. Can be repeated")
+	}
+	insertCmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "Insert every file in a directory")
+	insertCmd.Flags().BoolVarP(&overwrite, "force", "f", false, "Replace entries that already exist when inserting a directory")
 	showCmd.PersistentFlags().BoolVarP(&copyPass, "copy", "c", false, "Copy your password to the clipboard")
+	showCmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write the decrypted entry to a file")
+	execCmd.Flags().StringArrayVar(&envSpecs, "env", nil, "Set NAME to the contents of a site, as NAME=site. Can be repeated")
+	// Flags after the command belong to it.
+	execCmd.Flags().SetInterspersed(false)
+	extractCmd.Flags().BoolVarP(&overwrite, "force", "f", false, "Replace files that already exist")
+	generateCmd.Flags().BoolVarP(&copyPass, "copy", "c", false, "Copy the stored password to the clipboard")
+	generateCmd.Flags().BoolVarP(&printPass, "print", "p", false, "Print the stored password")
+	generateCmd.Flags().BoolVarP(&inPlace, "in-place", "i", false, "Replace the password of an existing site")
+	generateCmd.Flags().IntVarP(&phraseSpecs.Words, "words", "w", 0, "Generate a passphrase with this many words")
+	generateCmd.Flags().StringVarP(&phraseSpecs.Separator, "separator", "s", " ", "Separator between passphrase words")
+	generateCmd.Flags().BoolVar(&phraseSpecs.Capitalize, "capitalize", false, "Capitalize every passphrase word")
+	generateCmd.Flags().BoolVar(&phraseSpecs.Digit, "digit", false, "Add a random digit to the passphrase")
+	generateCmd.Flags().StringVar(&wordlistPath, "wordlist", "", "Path to a custom wordlist file, one word per line")
+	generateCmd.Flags().StringVar(&pwSpecs.Charset, "charset", "", "Characters to generate the password from, e.g. a-zA-Z0-9")
+	generateCmd.Flags().StringVar(&pwSpecs.Exclude, "exclude", "", "Characters that must not appear in the password")
+	generateCmd.Flags().BoolVar(&pwSpecs.NoAmbiguous, "no-ambiguous", false, "Exclude easily confused characters ("+pc.AmbiguousChars+")")
+	generateCmd.Flags().IntVar(&pwSpecs.MinUpper, "min-upper", 0, "Minimum number of upper case letters")
+	generateCmd.Flags().IntVar(&pwSpecs.MinLower, "min-lower", 0, "Minimum number of lower case letters")
+	generateCmd.Flags().IntVar(&pwSpecs.MinDigits, "min-digits", 0, "Minimum number of digits")
+	generateCmd.Flags().IntVar(&pwSpecs.MinSymbols, "min-symbols", 0, "Minimum number of symbols")
+	generateCmd.Flags().IntVar(&pwSpecs.MaxUpper, "max-upper", 0, "Maximum number of upper case letters, 0 for no limit")
+	generateCmd.Flags().IntVar(&pwSpecs.MaxLower, "max-lower", 0, "Maximum number of lower case letters, 0 for no limit")
+	generateCmd.Flags().IntVar(&pwSpecs.MaxDigits, "max-digits", 0, "Maximum number of digits, 0 for no limit")
+	generateCmd.Flags().IntVar(&pwSpecs.MaxSymbols, "max-symbols", 0, "Maximum number of symbols, 0 for no limit")
+	generateCmd.Flags().StringVar(&policyName, "policy", "", "Generate a password using a saved policy")
+	generateCmd.Flags().StringVar(&savePolicy, "save-policy", "", "Save the password rules as a named policy")
+	for _, cmd := range []*cobra.Command{RootCmd, lsCmd} {
+		cmd.Flags().StringVar(&sortBy, "sort", "name", "Order sites by name, modified or created")
+	}
+	findCmd.Flags().StringVar(&findSort, "sort", "score", "Order sites by score, name, modified or created")
+	findCmd.Flags().BoolVarP(&findOpts.Regex, "regex", "e", false, "Search with a regular expression")
+	findCmd.Flags().BoolVarP(&findOpts.CaseSensitive, "case-sensitive", "s", false, "Do not ignore case")
+	sshAgentCmd.Flags().StringVar(&agentSocket, "socket", sshkey.DefaultSocket(), "Listen on a Unix socket at this path")
+	sshAgentCmd.Flags().BoolVar(&confirmUse, "confirm", false, "Ask before every signature")
+	mountCmd.Flags().DurationVar(&lockAfter, "timeout", 15*time.Minute, "Lock and unmount the vault after it has not been read for this long")
+	paperBackupCmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write the backup to a file instead of stdout")
+	paperBackupCmd.Flags().StringVar(&qrPath, "qr", "", "Also write a QR code of the backup to a PNG or SVG file")
+	paperRestoreCmd.Flags().BoolVarP(&overwrite, "force", "f", false, "Replace the config file if there is one")
+	recoverySplitCmd.Flags().IntVarP(&shareCount, "shares", "n", 5, "Number of shares to split the key in to")
+	recoverySplitCmd.Flags().IntVarP(&threshold, "threshold", "k", 3, "Number of shares needed to recover the key")
+	recoverySplitCmd.Flags().BoolVar(&qrShares, "qr", false, "Print shares as strings for QR codes instead of words")
+	removeCmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "Remove a group and all of its subgroups")
+	serveCmd.Flags().StringVar(&socketPath, "socket", "", "Serve on a Unix socket at this path")
+	serveCmd.Flags().StringVar(&serveAddr, "addr", serve.DefaultAddr, "Serve on this loopback address")
+	serveCmd.Flags().StringVar(&tokenFile, "token-file", "", "Read the bearer token for --addr from a file")
+	serveCmd.Flags().BoolVar(&noPrompt, "no-prompt", false, "Return passwords without asking for approval")
+	auditCmd.PersistentFlags().BoolVarP(&weakOnly, "weak", "w", false, "Only report weak passwords")
+	RootCmd.AddCommand(auditCmd)
+	RootCmd.AddCommand(execCmd)
+	RootCmd.AddCommand(extractCmd)
 	RootCmd.AddCommand(findCmd)
 	RootCmd.AddCommand(generateCmd)
 	RootCmd.AddCommand(initCmd)
+	RootCmd.AddCommand(infoCmd)
+	RootCmd.AddCommand(injectCmd)
 	RootCmd.AddCommand(insertCmd)
+	keyfileCmd.AddCommand(keyfileRemoveCmd)
+	keyfileCmd.AddCommand(keyfileSetCmd)
+	RootCmd.AddCommand(keyfileCmd)
+	RootCmd.AddCommand(lsCmd)
+	RootCmd.AddCommand(mountCmd)
+	RootCmd.AddCommand(nativeHostCmd)
+	RootCmd.AddCommand(paperBackupCmd)
+	RootCmd.AddCommand(paperRestoreCmd)
+	recoveryCmd.AddCommand(recoveryCombineCmd)
+	recoveryCmd.AddCommand(recoverySplitCmd)
+	RootCmd.AddCommand(recoveryCmd)
 	RootCmd.AddCommand(removeCmd)
 	RootCmd.AddCommand(editCmd)
 	RootCmd.AddCommand(renameCmd)
+	RootCmd.AddCommand(serveCmd)
 	RootCmd.AddCommand(showCmd)
+	sshKeyCmd.AddCommand(sshKeyGenerateCmd)
+	sshKeyCmd.AddCommand(sshKeyImportCmd)
+	sshKeyCmd.AddCommand(sshKeyPublicCmd)
+	RootCmd.AddCommand(sshAgentCmd)
+	RootCmd.AddCommand(sshKeyCmd)
+	RootCmd.AddCommand(statsCmd)
+	RootCmd.AddCommand(tuiCmd)
 	RootCmd.AddCommand(versionCmd)
 }
 
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/format"
+	"github.com/ejcx/passgo/v2/internal/vaulttest"
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+	"github.com/ejcx/passgo/v2/sshkey"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// runPassgo runs passgo with args, answering prompts with the lines in
+// input, and returns what was printed to stdout.
+func runPassgo(t *testing.T, input string, args ...string) string {
+	pio.Input = pio.NewStreamPrompter(strings.NewReader(input), ioutil.Discard)
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Could not create pipe: %s", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+	out := make(chan string)
+	go func() {
+		var b bytes.Buffer
+		io.Copy(&b, r)
+		out <- b.String()
+	}()
+	resetFlags(RootCmd)
+	RootCmd.SetArgs(args)
+	err = RootCmd.Execute()
+	w.Close()
+	if err != nil {
+		t.Fatalf("passgo %s failed: %s", strings.Join(args, " "), err)
+	}
+	return <-out
+}
+
+// resetFlags sets the flags of cmd and its subcommands back to their
+// defaults, since they keep their values between runs.
+func resetFlags(cmd *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		// Setting a slice flag appends once it has been set, so
+		// those are emptied below instead.
+		if !strings.HasSuffix(f.Value.Type(), "Slice") && !strings.HasSuffix(f.Value.Type(), "Array") {
+			f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	cmd.Flags().VisitAll(reset)
+	cmd.PersistentFlags().VisitAll(reset)
+	for _, c := range cmd.Commands() {
+		resetFlags(c)
+	}
+	siteMeta.Tags = nil
+	envSpecs = nil
+}
+
+func TestInsertAndShow(t *testing.T) {
+	_, cleanup := vaulttest.New(t)
+	defer cleanup()
+
+	runPassgo(t, "hunter2\n", "insert", "money/bank.com")
+	if out := runPassgo(t, "", "find", "bank"); !strings.Contains(out, "bank.com") {
+		t.Errorf("find did not list the inserted site: %q", out)
+	}
+	if out := runPassgo(t, "master\n", "show", "money/bank.com"); out != "hunter2\n" {
+		t.Errorf("show: expected hunter2, actual %q", out)
+	}
+	runPassgo(t, "correct horse battery staple\n", "edit", "money/bank.com")
+	if out := runPassgo(t, "master\n", "show", "money/bank.com"); out != "correct horse battery staple\n" {
+		t.Errorf("show after edit: expected the new password, actual %q", out)
+	}
+}
+
+func TestInsertRecursive(t *testing.T) {
+	dir, cleanup := vaulttest.New(t)
+	defer cleanup()
+
+	src := filepath.Join(dir, "certs")
+	files := map[string]string{
+		"ca.pem":           "ca",
+		"prod/server.key":  "key",
+		"prod/db/data.sql": strings.Repeat("insert into t values (1);\n", 100),
+	}
+	for name, contents := range files {
+		p := filepath.Join(src, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(p), 0700)
+		if err := ioutil.WriteFile(p, []byte(contents), 0600); err != nil {
+			t.Fatalf("Could not write %s: %s", p, err)
+		}
+	}
+
+	runPassgo(t, "", "insert", "--recursive", "--compress", "--username", "alice", "certs/all", src)
+	out := runPassgo(t, "", "find", "data.sql")
+	if !strings.Contains(out, "data.sql") {
+		t.Errorf("find did not list a file in a subdirectory: %q", out)
+	}
+
+	// Existing entries are only replaced with --force.
+	ioutil.WriteFile(filepath.Join(src, "ca.pem"), []byte("new ca"), 0600)
+	runPassgo(t, "", "insert", "-r", "certs/all", src)
+	if out := runPassgo(t, "master\n", "show", "certs/all/ca.pem"); out != "ca\n" {
+		t.Errorf("insert without --force replaced an entry: %q", out)
+	}
+	runPassgo(t, "", "insert", "-rf", "certs/all", src)
+	files["ca.pem"] = "new ca"
+	username := func(name string) string {
+		for _, site := range pio.GetVault() {
+			if site.Name == name {
+				return site.Username
+			}
+		}
+		return ""
+	}
+	// Replaced entries keep their metadata unless new metadata is given.
+	if got := username("certs/all/ca.pem"); got != "alice" {
+		t.Errorf("insert --force replaced the username with %q", got)
+	}
+	runPassgo(t, "", "insert", "-rf", "--username", "bob", "certs/all", src)
+	if got := username("certs/all/ca.pem"); got != "bob" {
+		t.Errorf("insert --force --username kept the username %q", got)
+	}
+
+	dst := filepath.Join(dir, "out")
+	runPassgo(t, "master\n", "extract", "certs/all", dst)
+	for name, contents := range files {
+		b, err := ioutil.ReadFile(filepath.Join(dst, filepath.FromSlash(name)))
+		if err != nil {
+			t.Errorf("Could not read extracted %s: %s", name, err)
+		} else if string(b) != contents {
+			t.Errorf("Extracted %s does not match: %q", name, b)
+		}
+	}
+}
+
+func TestGroups(t *testing.T) {
+	_, cleanup := vaulttest.New(t)
+	defer cleanup()
+
+	for _, site := range []string{"work/aws/prod", "work/aws/stage", "work/gcp/prod", "home/wifi"} {
+		runPassgo(t, "hunter2\n", "insert", site)
+	}
+	expected := "work/aws\n├──prod\n└──stage\n"
+	if out := runPassgo(t, "", "ls", "work/aws"); out != expected {
+		t.Errorf("ls work/aws: expected %q, actual %q", expected, out)
+	}
+
+	runPassgo(t, "work/amazon\n", "rename", "work/aws")
+	if out := runPassgo(t, "master\n", "show", "work/amazon/stage"); out != "hunter2\n" {
+		t.Errorf("show after renaming group: %q", out)
+	}
+
+	runPassgo(t, "n\n", "remove", "-r", "work")
+	if out := runPassgo(t, "", "ls", "work"); !strings.Contains(out, "gcp") {
+		t.Errorf("remove -r removed sites without confirmation: %q", out)
+	}
+	runPassgo(t, "y\n", "remove", "-r", "work")
+	if out := runPassgo(t, "", "ls"); strings.Contains(out, "work") || !strings.Contains(out, "wifi") {
+		t.Errorf("remove -r did not remove just the group: %q", out)
+	}
+}
+
+func TestSiteInGroupOfSameName(t *testing.T) {
+	for _, order := range [][]string{{"a/b", "a/b/c"}, {"a/b/c", "a/b"}} {
+		dir, cleanup := vaulttest.New(t)
+
+		for _, site := range order {
+			runPassgo(t, site+"\n", "insert", site)
+		}
+		for _, site := range order {
+			if out := runPassgo(t, "master\n", "show", site); out != site+"\n" {
+				t.Errorf("show %s after inserting %v: %q", site, order, out)
+			}
+		}
+
+		runPassgo(t, "x\n", "rename", "a")
+		runPassgo(t, "y\n", "remove", "-r", "x")
+		files, err := ioutil.ReadDir(filepath.Join(dir, "vault", "files"))
+		if err != nil {
+			t.Fatalf("Could not read encrypted file dir: %s", err)
+		}
+		if len(files) != 0 {
+			t.Errorf("encrypted file dir is not empty after removing %v: %d entries", order, len(files))
+		}
+		cleanup()
+	}
+}
+
+func TestFormatJSON(t *testing.T) {
+	_, cleanup := vaulttest.New(t)
+	defer cleanup()
+
+	runPassgo(t, "hunter2\n", "insert", "money/bank.com")
+	var sites []format.Site
+	out := runPassgo(t, "", "ls", "--format", "json")
+	if err := json.Unmarshal([]byte(out), &sites); err != nil {
+		t.Fatalf("ls did not print JSON: %s: %q", err, out)
+	}
+	if len(sites) != 1 || sites[0].Name != "money/bank.com" || sites[0].Group != "money" || sites[0].Type != format.TypePassword || sites[0].Created == nil {
+		t.Errorf("Unexpected sites: %+v", sites)
+	}
+
+	var secret format.Secret
+	out = runPassgo(t, "master\n", "--format", "json", "show", "money/bank.com")
+	if err := json.Unmarshal([]byte(out), &secret); err != nil {
+		t.Fatalf("show did not print JSON: %s: %q", err, out)
+	}
+	if secret.Name != "money/bank.com" || secret.Secret != "hunter2" {
+		t.Errorf("Unexpected secret: %+v", secret)
+	}
+}
+
+func TestKeyfile(t *testing.T) {
+	dir, cleanup := vaulttest.New(t)
+	defer cleanup()
+	key := filepath.Join(dir, "passgo.key")
+	keyfile := func() string {
+		c, err := pio.ReadConfig()
+		if err != nil {
+			t.Fatalf("Could not read config: %s", err)
+		}
+		return c.Keyfile
+	}
+
+	// Migrate an existing vault to a keyfile and back.
+	runPassgo(t, "hunter2\n", "insert", "money/bank.com")
+	doc := filepath.Join(dir, "doc.txt")
+	ioutil.WriteFile(doc, []byte("contents"), 0600)
+	runPassgo(t, "", "insert", "docs/doc.txt", doc)
+	oldConfig, err := pio.ReadConfig()
+	if err != nil {
+		t.Fatalf("Could not read config: %s", err)
+	}
+	runPassgo(t, "master\n", "keyfile", "set", key)
+	if got := keyfile(); got != key {
+		t.Errorf("Config keyfile is %q, not %q", got, key)
+	}
+	config, _ := pio.GetConfigPath()
+	if _, err := os.Stat(config + ".old"); !os.IsNotExist(err) {
+		t.Errorf("The copy of the old config was not removed: %v", err)
+	}
+	if out := runPassgo(t, "master\n", "show", "money/bank.com"); out != "hunter2\n" {
+		t.Errorf("show with a keyfile: expected hunter2, actual %q", out)
+	}
+	if out := runPassgo(t, "master\n", "show", "docs/doc.txt"); out != "contents\n" {
+		t.Errorf("show of a file with a keyfile: expected contents, actual %q", out)
+	}
+	// The old config, as kept in git history, no longer opens the sites.
+	oldKey, err := pc.OpenMasterKey("master", &oldConfig)
+	if err != nil {
+		t.Fatalf("Could not open the old config: %s", err)
+	}
+	for _, site := range pio.GetVault() {
+		if r, err := pc.OpenSite(&site, &oldKey); err == nil {
+			if _, err = ioutil.ReadAll(r); err == nil {
+				t.Errorf("The old master key still opens %s", site.Name)
+			}
+			r.Close()
+		}
+	}
+	runPassgo(t, "master\n", "keyfile", "remove")
+	if got := keyfile(); got != "" {
+		t.Errorf("Config keyfile is %q after remove", got)
+	}
+	if out := runPassgo(t, "master\n", "show", "money/bank.com"); out != "hunter2\n" {
+		t.Errorf("show after removing the keyfile: expected hunter2, actual %q", out)
+	}
+
+	// Initialize a new vault with the same keyfile.
+	os.Setenv(pio.PASSGODIR, filepath.Join(dir, "keyed"))
+	runPassgo(t, "master\n", "init", "--keyfile", key)
+	if got := keyfile(); got != key {
+		t.Errorf("Config keyfile is %q, not %q", got, key)
+	}
+	runPassgo(t, "hunter2\n", "insert", "money/bank.com")
+	if out := runPassgo(t, "master\n", "show", "money/bank.com"); out != "hunter2\n" {
+		t.Errorf("show in a new vault with a keyfile: expected hunter2, actual %q", out)
+	}
+}
+
+func TestSocketDefaults(t *testing.T) {
+	resetFlags(RootCmd)
+	if agentSocket != sshkey.DefaultSocket() {
+		t.Errorf("ssh-agent listens on %q by default", agentSocket)
+	}
+	if socketPath != "" {
+		t.Errorf("serve listens on %q by default", socketPath)
+	}
+}
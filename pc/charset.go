@@ -0,0 +1,87 @@
+package pc
+
+// AmbiguousChars are characters that are easily confused with each other
+// when read or typed.
+const AmbiguousChars = "0O1lI"
+
+// charClass describes how many characters of a class a password needs.
+type charClass struct {
+	is       func(byte) bool
+	min, max int
+}
+
+// classes returns the upper case, lower case, symbol and digit classes of
+// specs with the Needs fields folded in to the minimum counts.
+func (specs *PasswordSpecs) classes() []charClass {
+	need := func(needs bool, min int) int {
+		if needs && min < 1 {
+			return 1
+		}
+		return min
+	}
+	return []charClass{
+		{isASCIIUpper, need(specs.NeedsUpper, specs.MinUpper), specs.MaxUpper},
+		{isASCIILower, need(specs.NeedsLower, specs.MinLower), specs.MaxLower},
+		{isASCIISymbol, need(specs.NeedsSymbol, specs.MinSymbols), specs.MaxSymbols},
+		{isASCIIDigit, need(specs.NeedsDigit, specs.MinDigits), specs.MaxDigits},
+	}
+}
+
+// Alphabet returns the sorted set of characters that passwords meeting
+// specs are drawn from.
+func (specs *PasswordSpecs) Alphabet() []byte {
+	var set [256]bool
+	if specs.Charset == "" {
+		for c := SymbolGrp1LowerBound; c <= SymbolGrp4UpperBound; c++ {
+			set[c] = true
+		}
+	} else {
+		for _, c := range expandCharset(specs.Charset) {
+			set[c] = true
+		}
+	}
+	for _, c := range []byte(specs.Exclude) {
+		set[c] = false
+	}
+	if specs.NoAmbiguous {
+		for _, c := range []byte(AmbiguousChars) {
+			set[c] = false
+		}
+	}
+	var alphabet []byte
+	for c, ok := range set {
+		// Only printable ascii characters may be used.
+		if ok && c >= SymbolGrp1LowerBound && c <= SymbolGrp4UpperBound {
+			alphabet = append(alphabet, byte(c))
+		}
+	}
+	return alphabet
+}
+
+// HasClasses reports which character classes are present in the alphabet
+// of specs.
+func (specs *PasswordSpecs) HasClasses() (upper, lower, symbol, digit bool) {
+	for _, c := range specs.Alphabet() {
+		upper = upper || isASCIIUpper(c)
+		lower = lower || isASCIILower(c)
+		symbol = symbol || isASCIISymbol(c)
+		digit = digit || isASCIIDigit(c)
+	}
+	return
+}
+
+// expandCharset expands ranges like a-z in charset. A - at the start or
+// end of charset is taken literally.
+func expandCharset(charset string) (chars []byte) {
+	for i := 0; i < len(charset); i++ {
+		if i+2 < len(charset) && charset[i+1] == '-' && charset[i] <= charset[i+2] {
+			for c := int(charset[i]); c <= int(charset[i+2]); c++ {
+				chars = append(chars, byte(c))
+			}
+			i += 2
+			continue
+		}
+		chars = append(chars, charset[i])
+	}
+	return
+}
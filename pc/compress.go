@@ -0,0 +1,52 @@
+package pc
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// Compressions lists the compression algorithms that can be used for
+// file entries.
+var Compressions = []string{pio.CompressionGzip}
+
+// CheckCompression returns an error if alg is not a supported compression
+// algorithm. An empty alg means no compression.
+func CheckCompression(alg string) error {
+	switch alg {
+	case pio.CompressionNone, pio.CompressionGzip:
+		return nil
+	}
+	return fmt.Errorf("Unsupported compression %q, must be one of %v", alg, Compressions)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// compressWriter returns a WriteCloser that compresses everything written
+// to it with alg before writing it to w. Closing it does not close w.
+func compressWriter(w io.Writer, alg string) (io.WriteCloser, error) {
+	switch alg {
+	case pio.CompressionNone:
+		return nopWriteCloser{w}, nil
+	case pio.CompressionGzip:
+		return gzip.NewWriter(w), nil
+	}
+	return nil, CheckCompression(alg)
+}
+
+// decompressReader returns a Reader that decompresses r with alg.
+func decompressReader(r io.Reader, alg string) (io.Reader, error) {
+	switch alg {
+	case pio.CompressionNone:
+		return r, nil
+	case pio.CompressionGzip:
+		return gzip.NewReader(r)
+	}
+	return nil, CheckCompression(alg)
+}
@@ -0,0 +1,101 @@
+package pc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ejcx/passgo/v2/pio"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// keyfileLen is the size of the keyfiles CreateKeyfile makes.
+	keyfileLen = 64
+	// keyfileInfo separates keys derived from keyfiles from any other
+	// use of HKDF.
+	keyfileInfo = "passgo keyfile"
+)
+
+// MasterPassKey derives the key that seals the master private key of c
+// from pass. It is the scrypt of pass, combined with the SHA-256 of the
+// keyfile using HKDF if the vault has one.
+func MasterPassKey(pass string, c *pio.ConfigFile) (key [32]byte, err error) {
+	passKey, err := Scrypt([]byte(pass), c.MasterPassKeySalt[:])
+	if err != nil {
+		return
+	}
+	path := c.KeyfilePath()
+	if path == "" {
+		return passKey, nil
+	}
+	fileHash, err := hashKeyfile(path)
+	if err != nil {
+		return
+	}
+	ikm := append(passKey[:], fileHash...)
+	_, err = io.ReadFull(hkdf.New(sha256.New, ikm, c.MasterPassKeySalt[:], []byte(keyfileInfo)), key[:])
+	return
+}
+
+// CreateKeyfile returns the absolute path of the keyfile at path, after
+// filling it with random bytes if it does not exist yet. The keyfile can
+// not be in the vault directory, which may be published.
+func CreateKeyfile(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	passDir, err := pio.GetPassDir()
+	if err != nil {
+		return "", err
+	}
+	if passDir, err = filepath.Abs(passDir); err != nil {
+		return "", err
+	}
+	if rel, err := filepath.Rel(passDir, path); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.New("The keyfile has to be kept out of the vault directory")
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0400)
+	if os.IsExist(err) {
+		_, err = hashKeyfile(path)
+		return path, err
+	}
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, keyfileLen)
+	if _, err = rand.Read(b); err == nil {
+		_, err = f.Write(b)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	return path, nil
+}
+
+func hashKeyfile(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read keyfile: %s", err.Error())
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read keyfile: %s", err.Error())
+	}
+	if n == 0 {
+		return nil, errors.New("The keyfile is empty")
+	}
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,81 @@
+package pc
+
+import (
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/pio"
+	"golang.org/x/crypto/curve25519"
+)
+
+func TestKeyfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "passgo")
+	if err != nil {
+		t.Fatalf("Could not create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv(pio.PASSGODIR, filepath.Join(dir, "vault"))
+	defer os.Unsetenv(pio.PASSGODIR)
+
+	path, err := CreateKeyfile(filepath.Join(dir, "key"))
+	if err != nil {
+		t.Fatalf("Could not create keyfile: %s", err)
+	}
+	contents, _ := ioutil.ReadFile(path)
+	if len(contents) != keyfileLen {
+		t.Fatalf("Keyfile has %d bytes", len(contents))
+	}
+	if again, err := CreateKeyfile(path); err != nil || again != path {
+		t.Fatalf("CreateKeyfile of an existing keyfile returned %s, %v", again, err)
+	}
+	if after, _ := ioutil.ReadFile(path); string(after) != string(contents) {
+		t.Fatalf("CreateKeyfile replaced an existing keyfile")
+	}
+	if _, err = CreateKeyfile(filepath.Join(dir, "vault", "key")); err == nil {
+		t.Errorf("Created a keyfile in the vault directory")
+	}
+
+	var priv [32]byte
+	rand.Read(priv[:])
+	c := pio.ConfigFile{Keyfile: path}
+	curve25519.ScalarBaseMult(&c.MasterPubKey, &priv)
+	if err = SealMasterKey("master", &priv, &c); err != nil {
+		t.Fatalf("Could not seal master key: %s", err)
+	}
+	if got, err := OpenMasterKey("master", &c); err != nil || got != priv {
+		t.Fatalf("OpenMasterKey returned %v", err)
+	}
+	if _, err = OpenMasterKey("wrong", &c); err == nil {
+		t.Errorf("Opened the master key with the wrong password")
+	}
+	// The password alone is not enough.
+	passKey, _ := Scrypt([]byte("master"), c.MasterPassKeySalt[:])
+	if _, err = Open(&passKey, c.MasterKeyPrivSealed); err == nil {
+		t.Errorf("Opened the master key without the keyfile")
+	}
+
+	other := filepath.Join(dir, "other")
+	ioutil.WriteFile(other, []byte("not the keyfile"), 0600)
+	os.Setenv(pio.KeyfileEnv, other)
+	if _, err = OpenMasterKey("master", &c); err == nil {
+		t.Errorf("Opened the master key with the wrong keyfile")
+	}
+	os.Setenv(pio.KeyfileEnv, path)
+	if _, err = OpenMasterKey("master", &c); err != nil {
+		t.Errorf("Could not open the master key with $%s: %s", pio.KeyfileEnv, err)
+	}
+	os.Unsetenv(pio.KeyfileEnv)
+
+	ioutil.WriteFile(other, nil, 0600)
+	c.Keyfile = other
+	if _, err = MasterPassKey("master", &c); err == nil {
+		t.Errorf("Derived a key from an empty keyfile")
+	}
+	c.Keyfile = filepath.Join(dir, "missing")
+	if _, err = MasterPassKey("master", &c); err == nil {
+		t.Errorf("Derived a key from a missing keyfile")
+	}
+}
@@ -0,0 +1,91 @@
+package pc
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultPassphraseWords is the number of words used in a passphrase
+	// when none is specified. Six words from the EFF long wordlist give
+	// roughly 77 bits of entropy.
+	DefaultPassphraseWords = 6
+)
+
+// PassphraseSpecs indicates specifications for a desired generated passphrase.
+type PassphraseSpecs struct {
+	Words      int
+	Separator  string
+	Capitalize bool
+	Digit      bool
+	// Wordlist is the list of words to choose from. The EFF long
+	// wordlist is used if it is empty.
+	Wordlist []string
+}
+
+// Wordlist returns the EFF long wordlist that passphrases are generated
+// from by default.
+func Wordlist() []string {
+	return append([]string(nil), effLongWordlist...)
+}
+
+// GeneratePassphrase is used to generate a diceware style passphrase
+// securely. Every word is chosen uniformly at random from the wordlist. If
+// Capitalize is set every word is capitalized, which adds no entropy, and
+// if Digit is set a random digit is appended to a random word.
+//
+// The returned bits are the entropy of the passphrase, assuming the
+// attacker knows the wordlist and the specs used.
+func GeneratePassphrase(specs *PassphraseSpecs) (pass string, bits float64, err error) {
+	wordlist := specs.Wordlist
+	if len(wordlist) == 0 {
+		wordlist = effLongWordlist
+	}
+	if len(wordlist) < 2 {
+		err = errors.New("Wordlist must contain at least two words")
+		return
+	}
+	if specs.Words < 1 {
+		err = errors.New("Passphrase must contain at least one word")
+		return
+	}
+	u := newUniformReader()
+	words := make([]string, specs.Words)
+	for i := range words {
+		var n int
+		n, err = u.intn(len(wordlist))
+		if err != nil {
+			return
+		}
+		words[i] = wordlist[n]
+		if specs.Capitalize {
+			words[i] = capitalize(words[i])
+		}
+	}
+	bits = float64(specs.Words) * math.Log2(float64(len(wordlist)))
+	if specs.Digit {
+		var word, digit int
+		if word, err = u.intn(len(words)); err != nil {
+			return
+		}
+		if digit, err = u.intn(10); err != nil {
+			return
+		}
+		words[word] += string('0' + byte(digit))
+		bits += math.Log2(float64(10 * len(words)))
+	}
+	pass = strings.Join(words, specs.Separator)
+	return
+}
+
+// capitalize upper cases the first letter of word.
+func capitalize(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	if r == utf8.RuneError {
+		return word
+	}
+	return string(unicode.ToUpper(r)) + word[size:]
+}
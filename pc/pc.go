@@ -20,7 +20,14 @@ import (
 )
 
 const (
-	MaxPwLength = 2048
+	// MaxPwLength is the longest password GeneratePassword will generate.
+	MaxPwLength = 1 << 20
+
+	// ScryptN, ScryptR and ScryptP are the scrypt parameters used by
+	// Scrypt.
+	ScryptN = 262144
+	ScryptR = 8
+	ScryptP = 1
 )
 
 var (
@@ -65,6 +72,27 @@ type PasswordSpecs struct {
 	NeedsLower  bool
 	NeedsSymbol bool
 	NeedsDigit  bool
+
+	// Charset is the set of characters passwords are drawn from. Ranges
+	// such as a-z are allowed. All printable ascii characters are used
+	// if it is empty.
+	Charset string `json:",omitempty"`
+	// Exclude lists characters that must never appear in the password.
+	Exclude string `json:",omitempty"`
+	// NoAmbiguous excludes characters that are easily confused, 0O1lI.
+	NoAmbiguous bool `json:",omitempty"`
+
+	// The minimum and maximum number of characters of each class. A max
+	// of zero means there is no limit. To forbid a class entirely use
+	// Charset or Exclude.
+	MinUpper   int `json:",omitempty"`
+	MinLower   int `json:",omitempty"`
+	MinSymbols int `json:",omitempty"`
+	MinDigits  int `json:",omitempty"`
+	MaxUpper   int `json:",omitempty"`
+	MaxLower   int `json:",omitempty"`
+	MaxSymbols int `json:",omitempty"`
+	MaxDigits  int `json:",omitempty"`
 }
 
 // Seal wraps that AEAD interface secretbox Seal and safely
@@ -115,7 +143,7 @@ func Open(key *[32]byte, ciphertext []byte) (message []byte, err error) {
 // Scrypt is a wrapper around scrypt.Key that performs the Scrypt
 // algorithm on the input with opinionated defaults.
 func Scrypt(pass, salt []byte) (key [32]byte, err error) {
-	keyBytes, err := scrypt.Key(pass, salt, 262144, 8, 1, 32)
+	keyBytes, err := scrypt.Key(pass, salt, ScryptN, ScryptR, ScryptP, 32)
 	copy(key[:], keyBytes)
 	return
 }
@@ -123,7 +151,7 @@ func Scrypt(pass, salt []byte) (key [32]byte, err error) {
 // GetMasterKey is used to prompt user's for their password, read the
 // user's passgo config file and decrypt the master private key.
 func GetMasterKey() (masterPrivKey [32]byte) {
-	pass, err := pio.PromptPass(pio.MasterPassPrompt)
+	pass, err := pio.PromptMasterPass(pio.MasterPassPrompt)
 	if err != nil {
 		log.Fatalf("Could not get master password: %s", err.Error())
 	}
@@ -141,30 +169,58 @@ func GetMasterKey() (masterPrivKey [32]byte) {
 	if err != nil {
 		log.Fatalf("Could not read unmarshal config file: %s", err.Error())
 	}
-	masterKey, err := Scrypt([]byte(pass), configFile.MasterPassKeySalt[:])
+	masterPrivKey, err = OpenMasterKey(pass, &configFile)
 	if err != nil {
-		log.Fatalf("Could not create master key: %s", err.Error())
+		log.Fatalf("Could not unlock vault: %s", err.Error())
 	}
+	return
+}
 
-	masterPrivKeySlice, err := Open(&masterKey, configFile.MasterKeyPrivSealed)
-
-	copy(masterPrivKey[:], masterPrivKeySlice)
+// OpenMasterKey decrypts the master private key in c with pass, and the
+// keyfile if the vault has one.
+func OpenMasterKey(pass string, c *pio.ConfigFile) (masterPrivKey [32]byte, err error) {
+	masterKey, err := MasterPassKey(pass, c)
 	if err != nil {
-		log.Fatalf("Could not decrypt private key: %s", err.Error())
+		return
+	}
+	masterPrivKeySlice, err := Open(&masterKey, c.MasterKeyPrivSealed)
+	if err != nil {
+		if c.Keyfile != "" {
+			return masterPrivKey, errors.New("Wrong master password or keyfile")
+		}
+		return masterPrivKey, errors.New("Wrong master password")
 	}
+	copy(masterPrivKey[:], masterPrivKeySlice)
 
 	// Sanity check the public key that is stored in the config file.
 	// If the public key has changed then we should error out and
 	// let the user know.
 	publicKey := new([32]byte)
 	curve25519.ScalarBaseMult(publicKey, &masterPrivKey)
-	if *publicKey != configFile.MasterPubKey {
-		log.Fatalf("Vault integrity cannot be verified: %s", errors.New("Wrong master public key"))
+	if *publicKey != c.MasterPubKey {
+		return [32]byte{}, errors.New("Vault integrity cannot be verified: Wrong master public key")
 	}
-
 	return
 }
 
+// SealMasterKey seals masterPrivKey with pass, and the keyfile if c has
+// one, under a new salt and stores it in c.
+func SealMasterKey(pass string, masterPrivKey *[32]byte, c *pio.ConfigFile) error {
+	if _, err := rand.Read(c.MasterPassKeySalt[:]); err != nil {
+		return err
+	}
+	masterKey, err := MasterPassKey(pass, c)
+	if err != nil {
+		return err
+	}
+	sealed, err := Seal(&masterKey, masterPrivKey[:])
+	if err != nil {
+		return err
+	}
+	c.MasterKeyPrivSealed = sealed
+	return nil
+}
+
 func checkBound(letter byte, lowerBound, upperBound int) bool {
 	if int(letter) >= lowerBound && int(letter) <= upperBound {
 		return true
@@ -189,39 +245,57 @@ func isASCIISymbol(letter byte) bool {
 }
 
 func passwordExpectationsPossible(specs *PasswordSpecs, passlen int) bool {
-	minLength := 0
-	if specs.NeedsUpper {
-		minLength++
-	}
-	if specs.NeedsLower {
-		minLength++
-	}
-	if specs.NeedsSymbol {
-		minLength++
+	alphabet := specs.Alphabet()
+	if len(alphabet) == 0 {
+		return false
 	}
-	if specs.NeedsDigit {
-		minLength++
+	minLength := 0
+	capacity := 0
+	for _, c := range specs.classes() {
+		present := false
+		for _, letter := range alphabet {
+			if c.is(letter) {
+				present = true
+				break
+			}
+		}
+		if !present {
+			if c.min > 0 {
+				return false
+			}
+			continue
+		}
+		if c.max > 0 && c.max < c.min {
+			return false
+		}
+		minLength += c.min
+		if c.max > 0 && capacity >= 0 {
+			capacity += c.max
+		} else {
+			capacity = -1
+		}
 	}
 	if passlen < minLength {
 		return false
 	}
+	if capacity >= 0 && passlen > capacity {
+		return false
+	}
 	return true
 }
 
 // GeneratePassword is used to generate a password like string securely.
-// GeneratePassword has no upper limit to the length of a password that
-// it can generate, but is restricted by the size of int.
-// It requires generation of a string password that has a upper case
-// letter, a lower case letter, a symbol, and a number.
+// GeneratePassword can generate passwords up to MaxPwLength characters
+// long, which is long enough to be used as a key for encrypting files.
 //
-// It works by reading a big block of randomness from the crypto rand
-// package and searching for printable characters. It will continue
-// to  read chunks of randomness until it has found a password that
-// meets the specifications of the PasswordSpec passed in to the func.
+// Every character is drawn uniformly from the alphabet allowed by specs
+// using rejection sampling, so no character is more likely than another.
+// Characters needed to satisfy the minimum count of each class are drawn
+// first from that class, the rest of the password is drawn from the whole
+// alphabet, skipping any class that has reached its maximum, and then the
+// password is shuffled so required characters can appear at any position
+// with equal probability.
 func GeneratePassword(specs *PasswordSpecs, passlen int) (pass string, err error) {
-	var (
-		letters [65535]byte
-	)
 	if !passwordExpectationsPossible(specs, passlen) {
 		err = errors.New("Invalid password specs and length passed in to generate password. Try generating a longer password")
 		return
@@ -230,56 +304,89 @@ func GeneratePassword(specs *PasswordSpecs, passlen int) (pass string, err error
 		err = fmt.Errorf("Max password length is %d. Generate a shorter password", MaxPwLength)
 		return
 	}
-	for {
-		pass = ""
-		_, err = rand.Read(letters[:])
+	alphabet := specs.Alphabet()
+	classes := specs.classes()
+	counts := make([]int, len(classes))
+	classOf := func(letter byte) int {
+		for i, c := range classes {
+			if c.is(letter) {
+				return i
+			}
+		}
+		return -1
+	}
+	u := newUniformReader()
+	letters := make([]byte, 0, passlen)
+	draw := func(from []byte) error {
+		n, err := u.intn(len(from))
 		if err != nil {
-			return
+			return err
 		}
+		letters = append(letters, from[n])
+		if i := classOf(from[n]); i >= 0 {
+			counts[i]++
+		}
+		return nil
+	}
 
-		for _, letter := range letters {
-			// Check to make sure that the letter is inside
-			// the range of printable characters
-			if letter > 32 && letter < 127 {
-				pass += string(letter)
+	// Satisfy the minimum count of every class first.
+	for _, c := range classes {
+		var members []byte
+		for _, letter := range alphabet {
+			if c.is(letter) {
+				members = append(members, letter)
 			}
-			// If it doesn't meet the specs, but we verified earlier that it is
-			// possible to meet the pw expectations, just try again.
-			if passlen == len(pass) {
-				if specs.MeetsSpecs(pass) {
-					return
-				}
-				continue
+		}
+		for k := 0; k < c.min; k++ {
+			if err = draw(members); err != nil {
+				return
+			}
+		}
+	}
+
+	// Fill the rest of the password from every class that is still open.
+	open := func() (open []byte) {
+		for _, letter := range alphabet {
+			i := classOf(letter)
+			if i < 0 || classes[i].max == 0 || counts[i] < classes[i].max {
+				open = append(open, letter)
 			}
 		}
+		return
+	}
+	available := open()
+	for len(letters) < passlen {
+		if err = draw(available); err != nil {
+			return
+		}
+		// Only recompute the alphabet when a class has filled up.
+		if i := classOf(letters[len(letters)-1]); i >= 0 && classes[i].max > 0 && counts[i] >= classes[i].max {
+			available = open()
+		}
 	}
+
+	if err = u.shuffle(letters); err != nil {
+		return
+	}
+	pass = string(letters)
+	return
 }
 
+// MeetsSpecs reports whether pass contains the character classes
+// required by specs, within the minimum and maximum counts of each class.
 func (specs *PasswordSpecs) MeetsSpecs(pass string) bool {
-	var (
-		needsUpper  = specs.NeedsUpper
-		needsLower  = specs.NeedsLower
-		needsSymbol = specs.NeedsSymbol
-		needsDigit  = specs.NeedsDigit
-	)
-	for i := 0; i < len(pass); i++ {
-		if isASCIIDigit(pass[i]) {
-			needsDigit = false
-		} else if isASCIIUpper(pass[i]) {
-			needsUpper = false
-		} else if isASCIILower(pass[i]) {
-			needsLower = false
-		} else if isASCIISymbol(pass[i]) {
-			needsSymbol = false
+	for _, c := range specs.classes() {
+		count := 0
+		for i := 0; i < len(pass); i++ {
+			if c.is(pass[i]) {
+				count++
+			}
 		}
-		// Optimization. Once we find out that we have everything
-		// that we need, return.
-		if !needsUpper && !needsLower && !needsSymbol && !needsDigit {
-			return !needsUpper && !needsLower && !needsSymbol && !needsDigit
+		if count < c.min || (c.max > 0 && count > c.max) {
+			return false
 		}
 	}
-	// The answer is false if the optmiziation didn't return true.
-	return false
+	return true
 }
 
 // GenHexString will generate a random 32 character hex string.
@@ -2,6 +2,7 @@ package pc
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 )
 
@@ -54,3 +55,240 @@ func TestGenerateShortPassword(t *testing.T) {
 		t.Fatalf("Bad length of password. Should be 4")
 	}
 }
+
+func TestGeneratePassphrase(t *testing.T) {
+	ps := &PassphraseSpecs{
+		Words:      DefaultPassphraseWords,
+		Separator:  "-",
+		Capitalize: true,
+		Digit:      true,
+	}
+	pass, bits, err := GeneratePassphrase(ps)
+	if err != nil {
+		t.Fatalf("Could not generate passphrase: %s", err)
+	}
+	words := strings.Split(pass, "-")
+	if len(words) != DefaultPassphraseWords {
+		t.Fatalf("Bad number of words in passphrase %q", pass)
+	}
+	for _, w := range words {
+		if w[0] < 'A' || w[0] > 'Z' {
+			t.Errorf("Word %q was not capitalized", w)
+		}
+	}
+	if !strings.ContainsAny(pass, "0123456789") {
+		t.Errorf("Passphrase %q does not contain a digit", pass)
+	}
+	if bits < 77 || bits > 85 {
+		t.Errorf("Unexpected passphrase entropy: %f", bits)
+	}
+}
+
+func TestGeneratePassphraseWordlist(t *testing.T) {
+	ps := &PassphraseSpecs{
+		Words:     4,
+		Separator: " ",
+		Wordlist:  []string{"correct", "horse", "battery", "staple"},
+	}
+	pass, bits, err := GeneratePassphrase(ps)
+	if err != nil {
+		t.Fatalf("Could not generate passphrase: %s", err)
+	}
+	for _, w := range strings.Fields(pass) {
+		if !strings.Contains("correct horse battery staple", w) {
+			t.Errorf("Word %q is not from the wordlist", w)
+		}
+	}
+	if bits != 8 {
+		t.Errorf("Expected 8 bits of entropy, got %f", bits)
+	}
+}
+
+func TestGeneratePassphraseCapitalizeUnicode(t *testing.T) {
+	ps := &PassphraseSpecs{
+		Words:      3,
+		Separator:  " ",
+		Capitalize: true,
+		Wordlist:   []string{"\u00e9clair", "\u00fcber"},
+	}
+	pass, _, err := GeneratePassphrase(ps)
+	if err != nil {
+		t.Fatalf("Could not generate passphrase: %s", err)
+	}
+	for _, w := range strings.Fields(pass) {
+		if w != "\u00c9clair" && w != "\u00dcber" {
+			t.Errorf("Word %q was not capitalized", w)
+		}
+	}
+}
+
+func TestGeneratePassphraseBadWordlist(t *testing.T) {
+	_, _, err := GeneratePassphrase(&PassphraseSpecs{Words: 6, Wordlist: []string{"one"}})
+	if err == nil {
+		t.Fatalf("Passphrase with a one word wordlist did not throw an error")
+	}
+}
+
+func TestGenerateCharsetPassword(t *testing.T) {
+	ps := &PasswordSpecs{
+		NeedsDigit:  true,
+		Charset:     "a-f0-9",
+		Exclude:     "a",
+		NoAmbiguous: true,
+		MinDigits:   2,
+		MaxDigits:   4,
+	}
+	pass, err := GeneratePassword(ps, 12)
+	if err != nil {
+		t.Fatalf("Could not generate password: %s", err)
+	}
+	digits := 0
+	for i := 0; i < len(pass); i++ {
+		if !strings.ContainsRune("bcdef23456789", rune(pass[i])) {
+			t.Fatalf("Password %q contains character %q outside the alphabet", pass, pass[i])
+		}
+		if isASCIIDigit(pass[i]) {
+			digits++
+		}
+	}
+	if digits < 2 || digits > 4 {
+		t.Fatalf("Password %q should have between 2 and 4 digits", pass)
+	}
+}
+
+func TestGenerateImpossibleCharsetPassword(t *testing.T) {
+	ps := &PasswordSpecs{
+		NeedsSymbol: true,
+		Charset:     "a-z",
+	}
+	if _, err := GeneratePassword(ps, 10); err == nil {
+		t.Fatalf("Password needing a symbol without symbols in the charset did not throw an error")
+	}
+	ps = &PasswordSpecs{
+		Charset:   "a-z0-9",
+		MaxLower:  2,
+		MaxDigits: 2,
+	}
+	if _, err := GeneratePassword(ps, 10); err == nil {
+		t.Fatalf("Password longer than the class maximums allow did not throw an error")
+	}
+}
+
+func TestPolicyLength(t *testing.T) {
+	p := &Policy{MinLength: 8, MaxLength: 16}
+	if l, err := p.Length(0, 24); err != nil || l != 16 {
+		t.Errorf("Policy length should default to the max length, got %d %v", l, err)
+	}
+	if _, err := p.Length(20, 24); err == nil {
+		t.Errorf("Policy length outside of the range did not throw an error")
+	}
+}
+
+func TestGenerateLongPassword(t *testing.T) {
+	pass, err := GeneratePassword(&PasswordSpecs{NeedsDigit: true}, 1<<16)
+	if err != nil {
+		t.Fatalf("Could not generate password: %s", err)
+	}
+	if len(pass) != 1<<16 {
+		t.Fatalf("Bad length of password. Should be %d", 1<<16)
+	}
+}
+
+// chiSquared returns the chi-squared statistic of observed counts against
+// a uniform distribution.
+func chiSquared(counts map[byte]int, buckets, total int) float64 {
+	expected := float64(total) / float64(buckets)
+	var stat float64
+	for i := 0; i < buckets; i++ {
+		diff := float64(counts[byte(i)]) - expected
+		stat += diff * diff / expected
+	}
+	return stat
+}
+
+func TestGeneratePasswordDistribution(t *testing.T) {
+	specs := &PasswordSpecs{}
+	alphabet := specs.Alphabet()
+	index := map[byte]byte{}
+	for i, letter := range alphabet {
+		index[letter] = byte(i)
+	}
+	counts := map[byte]int{}
+	total := 0
+	for i := 0; i < 200; i++ {
+		pass, err := GeneratePassword(specs, 500)
+		if err != nil {
+			t.Fatalf("Could not generate password: %s", err)
+		}
+		for k := 0; k < len(pass); k++ {
+			counts[index[pass[k]]]++
+			total++
+		}
+	}
+	// The critical value for 93 degrees of freedom at p = 0.0001.
+	if stat := chiSquared(counts, len(alphabet), total); stat > 148.2 {
+		t.Errorf("Characters are not uniformly distributed, chi-squared %f", stat)
+	}
+}
+
+func TestGeneratePasswordPlacement(t *testing.T) {
+	specs := &PasswordSpecs{
+		Charset:   "a-z0-9",
+		MinDigits: 1,
+		MaxDigits: 1,
+	}
+	const passlen = 8
+	counts := map[byte]int{}
+	total := 4000
+	for i := 0; i < total; i++ {
+		pass, err := GeneratePassword(specs, passlen)
+		if err != nil {
+			t.Fatalf("Could not generate password: %s", err)
+		}
+		positions := 0
+		for k := 0; k < len(pass); k++ {
+			if isASCIIDigit(pass[k]) {
+				counts[byte(k)]++
+				positions++
+			}
+		}
+		if positions != 1 {
+			t.Fatalf("Password %q should have exactly one digit", pass)
+		}
+	}
+	// The critical value for 7 degrees of freedom at p = 0.0001.
+	if stat := chiSquared(counts, passlen, total); stat > 29.9 {
+		t.Errorf("Required characters are not uniformly placed, chi-squared %f", stat)
+	}
+}
+
+func benchmarkGeneratePassword(b *testing.B, specs *PasswordSpecs, passlen int) {
+	for i := 0; i < b.N; i++ {
+		if _, err := GeneratePassword(specs, passlen); err != nil {
+			b.Fatalf("Could not generate password: %s", err)
+		}
+	}
+}
+
+func BenchmarkGeneratePassword24(b *testing.B) {
+	benchmarkGeneratePassword(b, &PasswordSpecs{
+		NeedsUpper:  true,
+		NeedsLower:  true,
+		NeedsSymbol: true,
+		NeedsDigit:  true,
+	}, 24)
+}
+
+func BenchmarkGeneratePassword4096(b *testing.B) {
+	benchmarkGeneratePassword(b, &PasswordSpecs{}, 4096)
+}
+
+func BenchmarkGeneratePasswordTightSpecs(b *testing.B) {
+	benchmarkGeneratePassword(b, &PasswordSpecs{
+		Charset:    "a-zA-Z0-9!@#",
+		MinUpper:   4,
+		MinDigits:  4,
+		MinSymbols: 4,
+		MaxSymbols: 4,
+	}, 16)
+}
@@ -0,0 +1,91 @@
+package pc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// Policy is a named set of password rules, such as the rules enforced by a
+// particular site. Policies are saved in the policy file in the passgo
+// directory so that they can be reused.
+type Policy struct {
+	MinLength int `json:",omitempty"`
+	MaxLength int `json:",omitempty"`
+	PasswordSpecs
+}
+
+// Policies maps policy names to policies.
+type Policies map[string]Policy
+
+// Length returns the length a password generated with p should have. The
+// longest allowed length is preferred when pwlen is less than 1.
+func (p *Policy) Length(pwlen, defaultLen int) (int, error) {
+	if pwlen < 1 {
+		pwlen = defaultLen
+		if p.MaxLength > 0 {
+			pwlen = p.MaxLength
+		}
+		if pwlen < p.MinLength {
+			pwlen = p.MinLength
+		}
+		return pwlen, nil
+	}
+	if pwlen < p.MinLength || (p.MaxLength > 0 && pwlen > p.MaxLength) {
+		return 0, fmt.Errorf("Password length %d is outside of the policy's range of %d-%d", pwlen, p.MinLength, p.MaxLength)
+	}
+	return pwlen, nil
+}
+
+// GetPolicies reads the saved password policies. It is not an error for
+// the policy file to not exist.
+func GetPolicies() (policies Policies, err error) {
+	policies = Policies{}
+	path, err := pio.GetPolicyFile()
+	if err != nil {
+		return
+	}
+	policyBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
+	}
+	err = json.Unmarshal(policyBytes, &policies)
+	return
+}
+
+// GetPolicy returns the saved password policy called name.
+func GetPolicy(name string) (p Policy, err error) {
+	policies, err := GetPolicies()
+	if err != nil {
+		return
+	}
+	p, ok := policies[name]
+	if !ok {
+		err = fmt.Errorf("No password policy named %s", name)
+	}
+	return
+}
+
+// SavePolicy adds or replaces the password policy called name.
+func SavePolicy(name string, p Policy) error {
+	policies, err := GetPolicies()
+	if err != nil {
+		return err
+	}
+	policies[name] = p
+	policyBytes, err := json.MarshalIndent(policies, "", "\t")
+	if err != nil {
+		return err
+	}
+	path, err := pio.GetPolicyFile()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, policyBytes, 0600)
+}
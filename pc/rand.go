@@ -0,0 +1,67 @@
+package pc
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// uniformReader draws uniformly distributed integers from a source of
+// random bytes. Values that would make some results more likely than
+// others, which taking a plain modulus does, are rejected and drawn again.
+type uniformReader struct {
+	r   *bufio.Reader
+	buf [4]byte
+}
+
+// newUniformReader returns a uniformReader that reads from crypto/rand.
+// Reads are buffered so generating a long password only needs a handful
+// of reads from the operating system.
+func newUniformReader() *uniformReader {
+	return &uniformReader{r: bufio.NewReaderSize(rand.Reader, 4096)}
+}
+
+// intn returns a uniformly distributed random number in [0, n).
+func (u *uniformReader) intn(n int) (int, error) {
+	if n < 1 || int64(n) > 1<<32 {
+		return 0, errors.New("Random number range is out of bounds")
+	}
+	if n <= 256 {
+		// The largest multiple of n that fits in a byte.
+		limit := 256 - 256%n
+		for {
+			b, err := u.r.ReadByte()
+			if err != nil {
+				return 0, err
+			}
+			if int(b) < limit {
+				return int(b) % n, nil
+			}
+		}
+	}
+	limit := uint64(1<<32) - uint64(1<<32)%uint64(n)
+	for {
+		if _, err := io.ReadFull(u.r, u.buf[:]); err != nil {
+			return 0, err
+		}
+		v := uint64(binary.BigEndian.Uint32(u.buf[:]))
+		if v < limit {
+			return int(v % uint64(n)), nil
+		}
+	}
+}
+
+// shuffle randomly permutes b in place using the Fisher-Yates algorithm,
+// so every ordering of b is equally likely.
+func (u *uniformReader) shuffle(b []byte) error {
+	for i := len(b) - 1; i > 0; i-- {
+		j, err := u.intn(i + 1)
+		if err != nil {
+			return err
+		}
+		b[i], b[j] = b[j], b[i]
+	}
+	return nil
+}
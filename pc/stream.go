@@ -0,0 +1,338 @@
+package pc
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ejcx/passgo/v2/pio"
+	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+// Streams are used to encrypt file entries in constant memory. They follow
+// the STREAM construction: the plaintext is split in to chunks that are
+// each sealed with secretbox, using a nonce made of a random prefix, the
+// chunk counter and a flag that is only set on the final chunk. Chunks
+// can therefore not be reordered, dropped or appended, and truncating the
+// stream at a chunk boundary is detected because the final flag is missing.
+//
+// A stream is laid out as:
+//
+//	magic (4 bytes) | nonce prefix (16 bytes) | chunk 0 | chunk 1 | ...
+//
+// where every chunk except the last contains StreamChunkSize bytes of
+// plaintext plus secretbox.Overhead bytes of authenticator.
+
+const (
+	// StreamChunkSize is the amount of plaintext sealed in each chunk.
+	StreamChunkSize = 64 * 1024
+
+	streamPrefixSize = 16
+	streamMaxChunks  = 1 << 56
+)
+
+var (
+	streamMagic = []byte("PGS1")
+
+	// ErrStreamTruncated is returned when a stream ends before its final chunk.
+	ErrStreamTruncated = errors.New("Encrypted stream is truncated")
+	// ErrStreamCorrupt is returned when a chunk of a stream fails to authenticate.
+	ErrStreamCorrupt = errors.New("Unable to decrypt message: encrypted stream is corrupt")
+)
+
+type streamState struct {
+	key    [32]byte
+	prefix [streamPrefixSize]byte
+	nonce  [24]byte
+	count  uint64
+}
+
+// next returns the nonce for the next chunk.
+func (s *streamState) next(final bool) (*[24]byte, error) {
+	if s.count >= streamMaxChunks {
+		return nil, errors.New("Encrypted stream is too long")
+	}
+	copy(s.nonce[:], s.prefix[:])
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], s.count)
+	// The counter takes up 7 bytes and the final flag the last byte.
+	copy(s.nonce[streamPrefixSize:], counter[1:])
+	s.nonce[23] = 0
+	if final {
+		s.nonce[23] = 1
+	}
+	s.count++
+	return &s.nonce, nil
+}
+
+type streamWriter struct {
+	w      io.Writer
+	state  streamState
+	buf    []byte
+	out    []byte
+	closed bool
+}
+
+// NewStreamWriter returns a WriteCloser that encrypts everything written
+// to it for the owner of the public key pub, using the private key priv,
+// and writes the encrypted stream to w. Close must be called to write the
+// final chunk. It does not close w.
+func NewStreamWriter(w io.Writer, pub, priv *[32]byte) (io.WriteCloser, error) {
+	s := &streamWriter{
+		w:   w,
+		buf: make([]byte, 0, StreamChunkSize),
+	}
+	box.Precompute(&s.state.key, pub, priv)
+	if _, err := rand.Read(s.state.prefix[:]); err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(streamMagic); err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(s.state.prefix[:]); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *streamWriter) Write(p []byte) (n int, err error) {
+	if s.closed {
+		return 0, errors.New("Write to closed stream")
+	}
+	for len(p) > 0 {
+		// A full chunk is only sealed once more data arrives, because
+		// until then it may turn out to be the final chunk.
+		if len(s.buf) == StreamChunkSize {
+			if err = s.seal(false); err != nil {
+				return
+			}
+		}
+		c := copy(s.buf[len(s.buf):cap(s.buf)], p)
+		s.buf = s.buf[:len(s.buf)+c]
+		p = p[c:]
+		n += c
+	}
+	return
+}
+
+func (s *streamWriter) seal(final bool) error {
+	nonce, err := s.state.next(final)
+	if err != nil {
+		return err
+	}
+	s.out = secretbox.Seal(s.out[:0], s.buf, nonce, &s.state.key)
+	s.buf = s.buf[:0]
+	_, err = s.w.Write(s.out)
+	return err
+}
+
+// Close seals and writes the final chunk of the stream.
+func (s *streamWriter) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.seal(true)
+}
+
+type streamReader struct {
+	r     *bufio.Reader
+	state streamState
+	in    []byte
+	plain []byte
+	out   []byte
+	done  bool
+	err   error
+}
+
+// NewStreamReader returns a Reader that decrypts the stream read from r,
+// which must have been sealed with the private key matching pub by the
+// owner of priv. Data is only returned once the chunk containing it has
+// been authenticated, and reading fails if the stream has been truncated,
+// reordered or otherwise modified.
+func NewStreamReader(r io.Reader, pub, priv *[32]byte) (io.Reader, error) {
+	s := &streamReader{
+		r:  bufio.NewReaderSize(r, StreamChunkSize+secretbox.Overhead),
+		in: make([]byte, StreamChunkSize+secretbox.Overhead),
+	}
+	box.Precompute(&s.state.key, pub, priv)
+	header := make([]byte, len(streamMagic)+streamPrefixSize)
+	if _, err := io.ReadFull(s.r, header); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			err = ErrStreamTruncated
+		}
+		return nil, err
+	}
+	if !bytes.Equal(header[:len(streamMagic)], streamMagic) {
+		return nil, errors.New("Not an encrypted stream")
+	}
+	copy(s.state.prefix[:], header[len(streamMagic):])
+	return s, nil
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	for len(s.out) == 0 {
+		if s.err != nil {
+			return 0, s.err
+		}
+		if s.done {
+			return 0, io.EOF
+		}
+		s.err = s.open()
+	}
+	n := copy(p, s.out)
+	s.out = s.out[n:]
+	return n, nil
+}
+
+// open reads and authenticates the next chunk.
+func (s *streamReader) open() error {
+	n, err := io.ReadFull(s.r, s.in)
+	final := false
+	switch err {
+	case nil:
+		// A full chunk is the final one if nothing follows it.
+		if _, perr := s.r.Peek(1); perr == io.EOF {
+			final = true
+		} else if perr != nil {
+			return perr
+		}
+	case io.ErrUnexpectedEOF:
+		final = true
+	case io.EOF:
+		return ErrStreamTruncated
+	default:
+		return err
+	}
+	if n < secretbox.Overhead {
+		return ErrStreamTruncated
+	}
+	nonce, err := s.state.next(final)
+	if err != nil {
+		return err
+	}
+	out, ok := secretbox.Open(s.plain[:0], s.in[:n], nonce, &s.state.key)
+	if !ok {
+		if final {
+			// The last chunk that is present may have been sealed as
+			// an intermediate chunk, meaning the stream was cut short.
+			if _, ok := secretbox.Open(nil, s.in[:n], s.nonceAt(s.state.count-1, false), &s.state.key); ok {
+				return ErrStreamTruncated
+			}
+		}
+		return ErrStreamCorrupt
+	}
+	s.plain = out
+	s.out = out
+	s.done = final
+	return nil
+}
+
+// nonceAt returns the nonce of chunk i without advancing the stream.
+func (s *streamReader) nonceAt(i uint64, final bool) *[24]byte {
+	state := s.state
+	state.count = i
+	nonce, _ := state.next(final)
+	n := *nonce
+	return &n
+}
+
+// OpenSite returns a reader of the decrypted password or file contents of
+// site. File entries stored as streams are decrypted as they are read;
+// other entries are decrypted all at once. Compressed file entries are
+// decompressed. The returned ReadCloser must be closed.
+func OpenSite(site *pio.SiteInfo, masterPrivKey *[32]byte) (io.ReadCloser, error) {
+	if !site.IsFile || !site.Streamed {
+		sealed, err := site.Sealed()
+		if err != nil {
+			return nil, err
+		}
+		unsealed, err := OpenAsym(sealed, &site.PubKey, masterPrivKey)
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(bytes.NewReader(unsealed)), nil
+	}
+	encFileDir, err := pio.GetEncryptedFilesDir()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(encFileDir, site.FileName))
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewStreamReader(f, &site.PubKey, masterPrivKey)
+	if err == nil {
+		r, err = decompressReader(r, site.Compression)
+	}
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{r, f}, nil
+}
+
+// SealSite encrypts everything read from r as a stream in to the encrypted
+// file of the file entry site, compressing it first with site.Compression.
+// The stream is sealed with priv for the owner of masterPub. Streamed and
+// Size are updated, but the site still needs to be saved to the vault and
+// the new contents moved in to place with site.CommitFile afterwards.
+func SealSite(site *pio.SiteInfo, r io.Reader, masterPub, priv *[32]byte) (err error) {
+	f, err := site.CreateFile()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		f.Close()
+		if err != nil {
+			site.DiscardFile()
+		}
+	}()
+	w, err := NewStreamWriter(f, masterPub, priv)
+	if err != nil {
+		return err
+	}
+	cw, err := compressWriter(w, site.Compression)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(cw, r)
+	if err != nil {
+		return err
+	}
+	if err = cw.Close(); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	site.Streamed = true
+	site.Size = n
+	return nil
+}
+
+// PayloadSize returns how many bytes were encrypted to produce sealedSize
+// bytes of encrypted data for site. For compressed file entries this is
+// the compressed size.
+func PayloadSize(site *pio.SiteInfo, sealedSize int64) int64 {
+	if !site.IsFile || !site.Streamed {
+		return sealedSize - 24 - box.Overhead
+	}
+	body := sealedSize - int64(len(streamMagic)) - streamPrefixSize
+	sealedChunk := int64(StreamChunkSize + secretbox.Overhead)
+	chunks := (body + sealedChunk - 1) / sealedChunk
+	return body - chunks*secretbox.Overhead
+}
@@ -0,0 +1,253 @@
+package pc
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/pio"
+	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+const sealedChunkSize = StreamChunkSize + secretbox.Overhead
+
+type streamKeys struct {
+	pub, priv             *[32]byte
+	masterPub, masterPriv *[32]byte
+}
+
+func newStreamKeys(t *testing.T) streamKeys {
+	pub, priv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Could not generate key: %s", err)
+	}
+	masterPub, masterPriv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Could not generate key: %s", err)
+	}
+	return streamKeys{pub, priv, masterPub, masterPriv}
+}
+
+func sealStream(t *testing.T, k streamKeys, plain []byte) []byte {
+	var buf bytes.Buffer
+	w, err := NewStreamWriter(&buf, k.masterPub, k.priv)
+	if err != nil {
+		t.Fatalf("Could not create stream writer: %s", err)
+	}
+	// Write in odd sized pieces so chunking does not depend on the caller.
+	for len(plain) > 0 {
+		n := 1000
+		if n > len(plain) {
+			n = len(plain)
+		}
+		if _, err = w.Write(plain[:n]); err != nil {
+			t.Fatalf("Could not write to stream: %s", err)
+		}
+		plain = plain[n:]
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("Could not close stream: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func openStream(k streamKeys, sealed []byte) ([]byte, error) {
+	r, err := NewStreamReader(bytes.NewReader(sealed), k.pub, k.masterPriv)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+func TestStreamRoundTrip(t *testing.T) {
+	k := newStreamKeys(t)
+	for _, size := range []int{0, 1, StreamChunkSize - 1, StreamChunkSize, StreamChunkSize + 1, 3*StreamChunkSize + 5} {
+		plain := make([]byte, size)
+		rand.Read(plain)
+		sealed := sealStream(t, k, plain)
+		chunks := size/StreamChunkSize + 1
+		if size > 0 && size%StreamChunkSize == 0 {
+			chunks--
+		}
+		if want := 20 + size + chunks*secretbox.Overhead; len(sealed) != want {
+			t.Errorf("Size %d: sealed length is %d, expected %d", size, len(sealed), want)
+		}
+		opened, err := openStream(k, sealed)
+		if err != nil {
+			t.Fatalf("Size %d: could not open stream: %s", size, err)
+		}
+		if !bytes.Equal(opened, plain) {
+			t.Fatalf("Size %d: opened stream does not match plaintext", size)
+		}
+	}
+}
+
+func TestStreamTampering(t *testing.T) {
+	k := newStreamKeys(t)
+	plain := make([]byte, 2*StreamChunkSize+100)
+	rand.Read(plain)
+	sealed := sealStream(t, k, plain)
+	header := sealed[:20]
+	chunk0 := sealed[20 : 20+sealedChunkSize]
+	chunk1 := sealed[20+sealedChunkSize : 20+2*sealedChunkSize]
+	chunk2 := sealed[20+2*sealedChunkSize:]
+
+	join := func(parts ...[]byte) []byte {
+		return bytes.Join(parts, nil)
+	}
+	flipped := append([]byte{}, sealed...)
+	flipped[20+sealedChunkSize+10] ^= 1
+
+	tests := []struct {
+		name   string
+		sealed []byte
+		err    error
+	}{
+		{"truncated header", sealed[:10], ErrStreamTruncated},
+		{"no chunks", header, ErrStreamTruncated},
+		{"truncated mid chunk", sealed[:20+sealedChunkSize+100], ErrStreamCorrupt},
+		{"truncated at chunk boundary", join(header, chunk0, chunk1), ErrStreamTruncated},
+		{"reordered chunks", join(header, chunk1, chunk0, chunk2), ErrStreamCorrupt},
+		{"dropped chunk", join(header, chunk0, chunk2), ErrStreamCorrupt},
+		{"flipped bit", flipped, ErrStreamCorrupt},
+		{"appended data", join(sealed, chunk0), ErrStreamCorrupt},
+	}
+	for _, tt := range tests {
+		_, err := openStream(k, tt.sealed)
+		if err != tt.err {
+			t.Errorf("%s: got error %v, expected %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestStreamWrongKey(t *testing.T) {
+	k := newStreamKeys(t)
+	sealed := sealStream(t, k, []byte("secret file contents"))
+	other := newStreamKeys(t)
+	k.masterPriv = other.masterPriv
+	if _, err := openStream(k, sealed); err != ErrStreamCorrupt {
+		t.Fatalf("Opening stream with wrong key returned %v", err)
+	}
+}
+
+func TestStreamPartialRead(t *testing.T) {
+	k := newStreamKeys(t)
+	plain := make([]byte, 2*StreamChunkSize)
+	rand.Read(plain)
+	sealed := sealStream(t, k, plain)
+	// Data from a chunk that fails to authenticate must never be returned.
+	sealed[len(sealed)-1] ^= 1
+	r, err := NewStreamReader(bytes.NewReader(sealed), k.pub, k.masterPriv)
+	if err != nil {
+		t.Fatalf("Could not create stream reader: %s", err)
+	}
+	opened, err := ioutil.ReadAll(r)
+	if err != ErrStreamCorrupt {
+		t.Fatalf("Reading corrupt stream returned %v", err)
+	}
+	if len(opened) != StreamChunkSize || !bytes.Equal(opened, plain[:StreamChunkSize]) {
+		t.Fatalf("Read %d bytes of a stream with a corrupt last chunk", len(opened))
+	}
+}
+
+func TestPayloadSize(t *testing.T) {
+	k := newStreamKeys(t)
+	site := &pio.SiteInfo{IsFile: true, Streamed: true}
+	for _, size := range []int{0, 1, StreamChunkSize, StreamChunkSize + 1, 3*StreamChunkSize + 5} {
+		sealed := sealStream(t, k, make([]byte, size))
+		if got := PayloadSize(site, int64(len(sealed))); got != int64(size) {
+			t.Errorf("Payload size of %d byte stream is %d", size, got)
+		}
+	}
+	sealed, err := SealAsym([]byte("hunter2"), k.masterPub, k.priv)
+	if err != nil {
+		t.Fatalf("Could not seal: %s", err)
+	}
+	if got := PayloadSize(&pio.SiteInfo{}, int64(len(sealed))); got != 7 {
+		t.Errorf("Payload size of sealed password is %d", got)
+	}
+}
+
+func TestCompressRoundTrip(t *testing.T) {
+	plain := bytes.Repeat([]byte("date,amount,description\n2019-01-01,10.00,coffee\n"), 1000)
+	for _, alg := range []string{pio.CompressionNone, pio.CompressionGzip} {
+		var buf bytes.Buffer
+		w, err := compressWriter(&buf, alg)
+		if err != nil {
+			t.Fatalf("Could not create %q writer: %s", alg, err)
+		}
+		w.Write(plain)
+		if err = w.Close(); err != nil {
+			t.Fatalf("Could not close %q writer: %s", alg, err)
+		}
+		if alg != pio.CompressionNone && buf.Len() >= len(plain)/10 {
+			t.Errorf("%q only compressed %d bytes to %d", alg, len(plain), buf.Len())
+		}
+		r, err := decompressReader(&buf, alg)
+		if err != nil {
+			t.Fatalf("Could not create %q reader: %s", alg, err)
+		}
+		out, err := ioutil.ReadAll(r)
+		if err != nil || !bytes.Equal(out, plain) {
+			t.Fatalf("%q round trip failed: %v", alg, err)
+		}
+	}
+	if _, err := compressWriter(ioutil.Discard, "zstd"); err == nil {
+		t.Fatalf("Unsupported compression did not return an error")
+	}
+}
+
+func TestSealSiteCommit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "passgo-stream")
+	if err != nil {
+		t.Fatalf("Could not create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv(pio.PASSGODIR, dir)
+	defer os.Unsetenv(pio.PASSGODIR)
+
+	k := newStreamKeys(t)
+	site := pio.SiteInfo{Name: "a/b", FileName: "a/b", IsFile: true, PubKey: *k.pub}
+	read := func() string {
+		r, err := OpenSite(&site, k.masterPriv)
+		if err != nil {
+			t.Fatalf("Could not open site: %s", err)
+		}
+		defer r.Close()
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("Could not read site: %s", err)
+		}
+		return string(b)
+	}
+	if err = SealSite(&site, strings.NewReader("old"), k.masterPub, k.priv); err != nil {
+		t.Fatalf("Could not seal site: %s", err)
+	}
+	if err = site.CommitFile(); err != nil {
+		t.Fatalf("Could not commit file: %s", err)
+	}
+	if err = SealSite(&site, strings.NewReader("new"), k.masterPub, k.priv); err != nil {
+		t.Fatalf("Could not seal site: %s", err)
+	}
+	if got := read(); got != "old" {
+		t.Errorf("Encrypted file was replaced before commit: got %q", got)
+	}
+	if err = site.CommitFile(); err != nil {
+		t.Fatalf("Could not commit file: %s", err)
+	}
+	if got := read(); got != "new" {
+		t.Errorf("Encrypted file was not replaced by commit: got %q", got)
+	}
+	entries, err := ioutil.ReadDir(filepath.Join(dir, pio.EncryptedFileDir, "a"))
+	if err != nil {
+		t.Fatalf("Could not read encrypted file dir: %s", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("Temporary files were left behind: %d entries", len(entries))
+	}
+}
@@ -0,0 +1,1027 @@
+package pc
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The strength estimator is modeled after zxcvbn. A password is broken into
+// every pattern that can be found in it (dictionary words, keyboard walks,
+// dates, repeats, sequences), each pattern is assigned a number of guesses an
+// attacker would need to find it, and the cheapest sequence of patterns that
+// covers the password determines the estimate.
+
+const (
+	// GuessesPerSecond is the assumed rate of an offline attack against a
+	// slow hash. It is used to turn guesses into a crack time.
+	GuessesPerSecond = 1e4
+
+	bruteforceCardinality           = 10
+	minGuessesBeforeGrowingSequence = 10000
+	minSubmatchGuessesSingleChar    = 10
+	minSubmatchGuessesMultiChar     = 50
+	minYearSpace                    = 20
+)
+
+// Pattern names reported in a Match.
+const (
+	PatternDictionary = "dictionary"
+	PatternSpatial    = "spatial"
+	PatternRepeat     = "repeat"
+	PatternSequence   = "sequence"
+	PatternDate       = "date"
+	PatternYear       = "year"
+	PatternBruteforce = "bruteforce"
+)
+
+// Match is a pattern found in a password.
+type Match struct {
+	Pattern string
+	Token   string
+	Guesses float64
+
+	// Dictionary is the name of the list a dictionary match came from.
+	Dictionary string
+	Rank       int
+	Reversed   bool
+	L33t       bool
+
+	i, j      int
+	turns     int
+	shifted   int
+	graph     *keyboardGraph
+	ascending bool
+}
+
+// Strength is the estimated strength of a password.
+type Strength struct {
+	// Guesses is the estimated number of guesses needed to crack the password.
+	Guesses float64
+	// Entropy is log2 of Guesses.
+	Entropy float64
+	// Score is a value from 0 (too guessable) to 4 (very unguessable).
+	Score int
+	// CrackTime is the estimated time to crack the password offline.
+	CrackTime time.Duration
+	// Warning explains the weakest part of the password, if any.
+	Warning string
+	// Sequence is the set of patterns that makes up the password.
+	Sequence []Match
+}
+
+var (
+	rankedDictionaries = map[string]map[string]int{
+		"passwords": buildRankedDict(passwordsList),
+		"english":   buildRankedDict(englishList),
+		"names":     buildRankedDict(namesList),
+		"surnames":  buildRankedDict(surnamesList),
+	}
+
+	l33tTable = map[byte][]byte{
+		'a': {'4', '@'},
+		'b': {'8'},
+		'c': {'(', '{', '[', '<'},
+		'e': {'3'},
+		'g': {'6', '9'},
+		'i': {'1', '!', '|'},
+		'l': {'1', '|', '7'},
+		'o': {'0'},
+		's': {'$', '5'},
+		't': {'+', '7'},
+		'x': {'%'},
+		'z': {'2'},
+	}
+
+	qwertyGraph = newSlantedGraph([]string{
+		"`~ 1! 2@ 3# 4$ 5% 6^ 7& 8* 9( 0) -_ =+",
+		"qQ wW eE rR tT yY uU iI oO pP [{ ]} \\|",
+		"aA sS dD fF gG hH jJ kK lL ;: '\"",
+		"zZ xX cC vV bB nN mM ,< .> /?",
+	})
+	keypadGraph = newAlignedGraph([]string{
+		"  / * -",
+		"7 8 9 +",
+		"4 5 6  ",
+		"1 2 3  ",
+		"  0 .  ",
+	})
+
+	dateWithSeparator = regexp.MustCompile(`^(\d{1,4})([\s/\\_.-])(\d{1,2})([\s/\\_.-])(\d{1,4})$`)
+	recentYear        = regexp.MustCompile(`19\d\d|20\d\d`)
+)
+
+func buildRankedDict(list string) map[string]int {
+	d := map[string]int{}
+	for i, w := range strings.Fields(list) {
+		if _, ok := d[w]; !ok {
+			d[w] = i + 1
+		}
+	}
+	return d
+}
+
+// EstimateStrength estimates how many guesses it would take to crack pass.
+// userInputs are additional words, such as the site name, that an attacker
+// could be expected to try.
+func EstimateStrength(pass string, userInputs ...string) Strength {
+	dicts := rankedDictionaries
+	if len(userInputs) > 0 {
+		dicts = map[string]map[string]int{}
+		for k, v := range rankedDictionaries {
+			dicts[k] = v
+		}
+		inputs := map[string]int{}
+		for i, in := range userInputs {
+			for _, w := range strings.FieldsFunc(strings.ToLower(in), isInputSeparator) {
+				if _, ok := inputs[w]; !ok {
+					inputs[w] = i + 1
+				}
+			}
+		}
+		dicts["user_inputs"] = inputs
+	}
+	return estimate(pass, dicts)
+}
+
+func isInputSeparator(r rune) bool {
+	return r == '/' || r == '.' || r == '-' || r == '_' || r == '@' || r == ' '
+}
+
+func estimate(pass string, dicts map[string]map[string]int) Strength {
+	matches := omnimatch(pass, dicts)
+	guesses, seq := mostGuessableSequence(pass, matches)
+	s := Strength{
+		Guesses:   guesses,
+		Entropy:   math.Log2(guesses),
+		Score:     guessesToScore(guesses),
+		CrackTime: guessesToDuration(guesses),
+		Sequence:  seq,
+	}
+	if len(pass) == 0 {
+		s.Entropy = 0
+	}
+	s.Warning = warning(s)
+	return s
+}
+
+func guessesToScore(guesses float64) int {
+	const delta = 5
+	switch {
+	case guesses < 1e3+delta:
+		return 0
+	case guesses < 1e6+delta:
+		return 1
+	case guesses < 1e8+delta:
+		return 2
+	case guesses < 1e10+delta:
+		return 3
+	}
+	return 4
+}
+
+func guessesToDuration(guesses float64) time.Duration {
+	seconds := guesses / GuessesPerSecond
+	if seconds >= float64(math.MaxInt64)/float64(time.Second) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// DisplayCrackTime returns a human readable approximation of the time it
+// would take to crack a password of the given Strength.
+func (s Strength) DisplayCrackTime() string {
+	const (
+		minute  = 60
+		hour    = minute * 60
+		day     = hour * 24
+		month   = day * 31
+		year    = month * 12
+		century = year * 100
+	)
+	seconds := s.Guesses / GuessesPerSecond
+	plural := func(n float64, unit string) string {
+		v := int64(math.Round(n))
+		if v == 1 {
+			return fmt.Sprintf("1 %s", unit)
+		}
+		return fmt.Sprintf("%d %ss", v, unit)
+	}
+	switch {
+	case seconds < 1:
+		return "less than a second"
+	case seconds < minute:
+		return plural(seconds, "second")
+	case seconds < hour:
+		return plural(seconds/minute, "minute")
+	case seconds < day:
+		return plural(seconds/hour, "hour")
+	case seconds < month:
+		return plural(seconds/day, "day")
+	case seconds < year:
+		return plural(seconds/month, "month")
+	case seconds < century:
+		return plural(seconds/year, "year")
+	}
+	return "centuries"
+}
+
+// ScoreName returns a short description of the strength score.
+func (s Strength) ScoreName() string {
+	return [...]string{"very weak", "weak", "fair", "strong", "very strong"}[s.Score]
+}
+
+func warning(s Strength) string {
+	if s.Score > 2 || len(s.Sequence) == 0 {
+		return ""
+	}
+	m := s.Sequence[0]
+	for _, sm := range s.Sequence {
+		if len(sm.Token) > len(m.Token) {
+			m = sm
+		}
+	}
+	switch m.Pattern {
+	case PatternDictionary:
+		if m.Dictionary == "passwords" && len(s.Sequence) == 1 && !m.L33t && !m.Reversed {
+			if m.Rank <= 10 {
+				return "This is a top-10 common password"
+			} else if m.Rank <= 100 {
+				return "This is a top-100 common password"
+			}
+			return "This is a very common password"
+		}
+		if m.Dictionary == "passwords" {
+			return "This is similar to a commonly used password"
+		}
+		if m.Dictionary == "english" && len(s.Sequence) == 1 {
+			return "A word by itself is easy to guess"
+		}
+		if m.Dictionary == "names" || m.Dictionary == "surnames" {
+			return "Names and surnames are easy to guess"
+		}
+		if m.Dictionary == "user_inputs" {
+			return "Passwords containing the site name are easy to guess"
+		}
+		return "Dictionary words are easy to guess"
+	case PatternSpatial:
+		if m.turns == 1 {
+			return "Straight rows of keys are easy to guess"
+		}
+		return "Short keyboard patterns are easy to guess"
+	case PatternRepeat:
+		return `Repeats like "abcabc" are easy to guess`
+	case PatternSequence:
+		return "Sequences like abc or 6543 are easy to guess"
+	case PatternDate:
+		return "Dates are often easy to guess"
+	case PatternYear:
+		return "Recent years are easy to guess"
+	}
+	return ""
+}
+
+// omnimatch returns every pattern that can be found in pass, sorted by
+// position.
+func omnimatch(pass string, dicts map[string]map[string]int) []Match {
+	var matches []Match
+	matches = append(matches, dictionaryMatch(pass, dicts)...)
+	matches = append(matches, reverseDictionaryMatch(pass, dicts)...)
+	matches = append(matches, l33tMatch(pass, dicts)...)
+	matches = append(matches, spatialMatch(pass, qwertyGraph)...)
+	matches = append(matches, spatialMatch(pass, keypadGraph)...)
+	matches = append(matches, repeatMatch(pass, dicts)...)
+	matches = append(matches, sequenceMatch(pass)...)
+	matches = append(matches, dateMatch(pass)...)
+	matches = append(matches, yearMatch(pass)...)
+	sort.SliceStable(matches, func(a, b int) bool {
+		if matches[a].i != matches[b].i {
+			return matches[a].i < matches[b].i
+		}
+		return matches[a].j < matches[b].j
+	})
+	return matches
+}
+
+func dictionaryMatch(pass string, dicts map[string]map[string]int) (matches []Match) {
+	lower := toLowerSameLength(pass)
+	names := make([]string, 0, len(dicts))
+	for name := range dicts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		dict := dicts[name]
+		for i := 0; i < len(pass); i++ {
+			for j := i; j < len(pass); j++ {
+				if rank, ok := dict[lower[i:j+1]]; ok {
+					m := Match{
+						Pattern:    PatternDictionary,
+						Token:      pass[i : j+1],
+						Dictionary: name,
+						Rank:       rank,
+						i:          i,
+						j:          j,
+					}
+					m.Guesses = dictionaryGuesses(m, "")
+					matches = append(matches, m)
+				}
+			}
+		}
+	}
+	return
+}
+
+func reverseDictionaryMatch(pass string, dicts map[string]map[string]int) (matches []Match) {
+	n := len(pass)
+	rev := reverse(pass)
+	for _, m := range dictionaryMatch(rev, dicts) {
+		m.Token = reverse(m.Token)
+		m.Reversed = true
+		m.i, m.j = n-1-m.j, n-1-m.i
+		m.Guesses = dictionaryGuesses(m, "")
+		matches = append(matches, m)
+	}
+	return
+}
+
+// toLowerSameLength lower cases s one rune at a time, leaving runes whose
+// lower case has a different length, such as the Kelvin sign, and invalid
+// UTF-8 as they are. Byte offsets into s are valid in the result.
+func toLowerSameLength(s string) string {
+	b := make([]byte, 0, len(s))
+	var buf [utf8.UTFMax]byte
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if l := unicode.ToLower(r); r != utf8.RuneError && utf8.RuneLen(l) == size {
+			b = append(b, buf[:utf8.EncodeRune(buf[:], l)]...)
+		} else {
+			b = append(b, s[i:i+size]...)
+		}
+		i += size
+	}
+	return string(b)
+}
+
+func reverse(s string) string {
+	b := []byte(s)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
+
+// l33tSubs returns every way the l33t characters found in pass can be
+// mapped back to letters.
+func l33tSubs(pass string) []map[byte]byte {
+	candidates := map[byte][]byte{}
+	for letter, subs := range l33tTable {
+		for _, sub := range subs {
+			if strings.IndexByte(pass, sub) >= 0 {
+				candidates[sub] = append(candidates[sub], letter)
+			}
+		}
+	}
+	keys := make([]byte, 0, len(candidates))
+	for k := range candidates {
+		keys = append(keys, k)
+		sort.Slice(candidates[k], func(a, b int) bool { return candidates[k][a] < candidates[k][b] })
+	}
+	sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+
+	subs := []map[byte]byte{{}}
+	for _, k := range keys {
+		var next []map[byte]byte
+		for _, s := range subs {
+			for _, letter := range candidates[k] {
+				ns := map[byte]byte{k: letter}
+				for sk, sv := range s {
+					ns[sk] = sv
+				}
+				next = append(next, ns)
+			}
+		}
+		subs = next
+		// Passwords made entirely of l33t characters can explode the
+		// number of substitutions. Those are caught by other matchers.
+		if len(subs) > 256 {
+			break
+		}
+	}
+	return subs
+}
+
+func l33tMatch(pass string, dicts map[string]map[string]int) (matches []Match) {
+	seen := map[string]bool{}
+	for _, sub := range l33tSubs(pass) {
+		if len(sub) == 0 {
+			continue
+		}
+		translated := []byte(pass)
+		for i := range translated {
+			if letter, ok := sub[translated[i]]; ok {
+				translated[i] = letter
+			}
+		}
+		for _, m := range dictionaryMatch(string(translated), dicts) {
+			token := pass[m.i : m.j+1]
+			if strings.ToLower(token) == strings.ToLower(m.Token) {
+				// No substitution took place in this token.
+				continue
+			}
+			// Single characters like "1" are not interesting l33t.
+			if len(token) <= 1 {
+				continue
+			}
+			key := fmt.Sprintf("%s:%d:%d:%s", m.Dictionary, m.i, m.j, m.Token)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			m.L33t = true
+			m.Guesses = dictionaryGuesses(m, token)
+			m.Token = token
+			matches = append(matches, m)
+		}
+	}
+	return
+}
+
+func dictionaryGuesses(m Match, l33tToken string) float64 {
+	guesses := float64(m.Rank) * uppercaseVariations(m.Token)
+	if m.L33t {
+		guesses *= l33tVariations(m.Token, l33tToken)
+	}
+	if m.Reversed {
+		guesses *= 2
+	}
+	return guesses
+}
+
+func uppercaseVariations(word string) float64 {
+	var upper, lower int
+	for i := 0; i < len(word); i++ {
+		if isASCIIUpper(word[i]) {
+			upper++
+		} else if isASCIILower(word[i]) {
+			lower++
+		}
+	}
+	if upper == 0 || word == strings.ToLower(word) {
+		return 1
+	}
+	first, last := word[0], word[len(word)-1]
+	if lower == 0 || (upper == 1 && (isASCIIUpper(first) || isASCIIUpper(last))) {
+		return 2
+	}
+	var variations float64
+	for i := 1; i <= upper && i <= lower; i++ {
+		variations += nCk(upper+lower, i)
+	}
+	return variations
+}
+
+// l33tVariations counts the ways the substituted characters of token could
+// have been chosen. word is the dictionary word, token the original text.
+func l33tVariations(word, token string) float64 {
+	variations := 1.0
+	counted := map[byte]bool{}
+	lowerWord := toLowerSameLength(word)
+	for i := 0; i < len(token) && i < len(lowerWord); i++ {
+		letter := lowerWord[i]
+		sub := token[i]
+		if sub == letter || isASCIIUpper(sub) || counted[sub] {
+			continue
+		}
+		counted[sub] = true
+		var s, u int
+		for k := 0; k < len(token) && k < len(lowerWord); k++ {
+			if token[k] == sub {
+				s++
+			} else if lowerWord[k] == letter {
+				u++
+			}
+		}
+		if s == 0 || u == 0 {
+			variations *= 2
+			continue
+		}
+		var possibilities float64
+		for k := 1; k <= s && k <= u; k++ {
+			possibilities += nCk(s+u, k)
+		}
+		variations *= possibilities
+	}
+	return variations
+}
+
+// keyboardGraph maps each key to its neighbors, in a fixed direction order,
+// so that changes in direction can be counted as turns.
+type keyboardGraph struct {
+	adjacent          map[byte][]string
+	startingPositions float64
+	averageDegree     float64
+}
+
+func newSlantedGraph(rows []string) *keyboardGraph {
+	type pos struct{ x, y int }
+	positions := map[pos]string{}
+	for y, row := range rows {
+		offset := 0
+		if y > 0 {
+			offset = 1
+		}
+		for x, key := range strings.Fields(row) {
+			positions[pos{x + offset, y}] = key
+		}
+	}
+	g := &keyboardGraph{adjacent: map[byte][]string{}}
+	for p, key := range positions {
+		neighbors := []pos{{p.x - 1, p.y}, {p.x, p.y - 1}, {p.x + 1, p.y - 1}, {p.x + 1, p.y}, {p.x, p.y + 1}, {p.x - 1, p.y + 1}}
+		adj := make([]string, len(neighbors))
+		for i, n := range neighbors {
+			adj[i] = positions[n]
+		}
+		for k := 0; k < len(key); k++ {
+			g.adjacent[key[k]] = adj
+		}
+	}
+	g.computeStats()
+	return g
+}
+
+func newAlignedGraph(rows []string) *keyboardGraph {
+	type pos struct{ x, y int }
+	positions := map[pos]string{}
+	for y, row := range rows {
+		for x := 0; x < len(row); x += 2 {
+			if row[x] != ' ' {
+				positions[pos{x / 2, y}] = row[x : x+1]
+			}
+		}
+	}
+	g := &keyboardGraph{adjacent: map[byte][]string{}}
+	for p, key := range positions {
+		var adj []string
+		for _, n := range []pos{{p.x - 1, p.y}, {p.x - 1, p.y - 1}, {p.x, p.y - 1}, {p.x + 1, p.y - 1}, {p.x + 1, p.y}, {p.x + 1, p.y + 1}, {p.x, p.y + 1}, {p.x - 1, p.y + 1}} {
+			adj = append(adj, positions[n])
+		}
+		g.adjacent[key[0]] = adj
+	}
+	g.computeStats()
+	return g
+}
+
+func (g *keyboardGraph) computeStats() {
+	var degrees float64
+	for _, adj := range g.adjacent {
+		for _, n := range adj {
+			if n != "" {
+				degrees++
+			}
+		}
+	}
+	g.startingPositions = float64(len(g.adjacent))
+	g.averageDegree = degrees / float64(len(g.adjacent))
+}
+
+// direction returns the index of next among the neighbors of prev and
+// whether next is the shifted character of its key.
+func (g *keyboardGraph) direction(prev, next byte) (dir int, shifted bool, ok bool) {
+	for i, n := range g.adjacent[prev] {
+		if idx := strings.IndexByte(n, next); idx >= 0 {
+			return i, idx == 1, true
+		}
+	}
+	return -1, false, false
+}
+
+func spatialMatch(pass string, g *keyboardGraph) (matches []Match) {
+	i := 0
+	for i < len(pass)-1 {
+		j := i + 1
+		lastDir := -1
+		turns := 0
+		shifted := 0
+		if g == qwertyGraph && strings.IndexByte(`~!@#$%^&*()_+QWERTYUIOP{}|ASDFGHJKL:"ZXCVBNM<>?`, pass[i]) >= 0 {
+			shifted = 1
+		}
+		for j < len(pass) {
+			dir, isShifted, ok := g.direction(pass[j-1], pass[j])
+			if !ok {
+				break
+			}
+			if isShifted {
+				shifted++
+			}
+			if dir != lastDir {
+				turns++
+				lastDir = dir
+			}
+			j++
+		}
+		if j-i > 2 {
+			m := Match{
+				Pattern: PatternSpatial,
+				Token:   pass[i:j],
+				i:       i,
+				j:       j - 1,
+				turns:   turns,
+				shifted: shifted,
+				graph:   g,
+			}
+			m.Guesses = spatialGuesses(m)
+			matches = append(matches, m)
+		}
+		i = j
+	}
+	return
+}
+
+func spatialGuesses(m Match) float64 {
+	s := m.graph.startingPositions
+	d := m.graph.averageDegree
+	var guesses float64
+	l := len(m.Token)
+	for i := 2; i <= l; i++ {
+		possibleTurns := m.turns
+		if i-1 < possibleTurns {
+			possibleTurns = i - 1
+		}
+		for j := 1; j <= possibleTurns; j++ {
+			guesses += nCk(i-1, j-1) * s * math.Pow(d, float64(j))
+		}
+	}
+	if m.shifted > 0 {
+		shifted := m.shifted
+		unshifted := l - shifted
+		if unshifted == 0 {
+			guesses *= 2
+		} else {
+			var variations float64
+			for i := 1; i <= shifted && i <= unshifted; i++ {
+				variations += nCk(shifted+unshifted, i)
+			}
+			guesses *= variations
+		}
+	}
+	return guesses
+}
+
+func repeatMatch(pass string, dicts map[string]map[string]int) (matches []Match) {
+	i := 0
+	for i < len(pass) {
+		found := false
+		for base := 1; i+2*base <= len(pass); base++ {
+			unit := pass[i : i+base]
+			repeats := 1
+			for k := i + base; k+base <= len(pass) && pass[k:k+base] == unit; k += base {
+				repeats++
+			}
+			if repeats < 2 {
+				continue
+			}
+			end := i + base*repeats
+			baseGuesses, _ := mostGuessableSequence(unit, omnimatch(unit, dicts))
+			matches = append(matches, Match{
+				Pattern: PatternRepeat,
+				Token:   pass[i:end],
+				i:       i,
+				j:       end - 1,
+				Guesses: baseGuesses * float64(repeats),
+			})
+			i = end
+			found = true
+			break
+		}
+		if !found {
+			i++
+		}
+	}
+	return
+}
+
+func sequenceMatch(pass string) (matches []Match) {
+	if len(pass) < 3 {
+		return
+	}
+	emit := func(i, j, delta int) {
+		if j-i < 2 {
+			return
+		}
+		abs := delta
+		if abs < 0 {
+			abs = -abs
+		}
+		if abs == 0 || abs > 5 {
+			return
+		}
+		m := Match{
+			Pattern:   PatternSequence,
+			Token:     pass[i : j+1],
+			i:         i,
+			j:         j,
+			ascending: delta > 0,
+		}
+		m.Guesses = sequenceGuesses(m)
+		matches = append(matches, m)
+	}
+	i := 0
+	lastDelta := 0
+	for k := 1; k < len(pass); k++ {
+		delta := int(pass[k]) - int(pass[k-1])
+		if k == 1 {
+			lastDelta = delta
+		}
+		if delta == lastDelta {
+			continue
+		}
+		j := k - 1
+		emit(i, j, lastDelta)
+		i = j
+		lastDelta = delta
+	}
+	emit(i, len(pass)-1, lastDelta)
+	return
+}
+
+func sequenceGuesses(m Match) float64 {
+	first := m.Token[0]
+	var base float64
+	switch {
+	case strings.IndexByte("aAzZ019", first) >= 0:
+		base = 4
+	case isASCIIDigit(first):
+		base = 10
+	default:
+		base = 26
+	}
+	if !m.ascending {
+		base *= 2
+	}
+	return base * float64(len(m.Token))
+}
+
+func referenceYear() int {
+	return time.Now().Year()
+}
+
+func yearSpace(year int) float64 {
+	space := math.Abs(float64(year - referenceYear()))
+	if space < minYearSpace {
+		space = minYearSpace
+	}
+	return space
+}
+
+func yearMatch(pass string) (matches []Match) {
+	for _, loc := range recentYear.FindAllStringIndex(pass, -1) {
+		year, _ := strconv.Atoi(pass[loc[0]:loc[1]])
+		matches = append(matches, Match{
+			Pattern: PatternYear,
+			Token:   pass[loc[0]:loc[1]],
+			i:       loc[0],
+			j:       loc[1] - 1,
+			Guesses: yearSpace(year),
+		})
+	}
+	return
+}
+
+func dateMatch(pass string) (matches []Match) {
+	for i := 0; i < len(pass); i++ {
+		for j := i + 3; j < len(pass) && j < i+10; j++ {
+			token := pass[i : j+1]
+			year, sep, ok := parseDate(token)
+			if !ok {
+				continue
+			}
+			m := Match{
+				Pattern: PatternDate,
+				Token:   token,
+				i:       i,
+				j:       j,
+			}
+			m.Guesses = yearSpace(year) * 365
+			if sep {
+				m.Guesses *= 4
+			}
+			matches = append(matches, m)
+		}
+	}
+	return
+}
+
+// parseDate reports whether token looks like a day, month and year, with or
+// without separators, and returns the year.
+func parseDate(token string) (year int, separator bool, ok bool) {
+	if m := dateWithSeparator.FindStringSubmatch(token); m != nil {
+		if m[2] != m[4] {
+			return 0, false, false
+		}
+		year, ok = dateFromParts(m[1], m[3], m[5])
+		return year, true, ok
+	}
+	for i := 0; i < len(token); i++ {
+		if !isASCIIDigit(token[i]) {
+			return 0, false, false
+		}
+	}
+	if len(token) < 4 || len(token) > 8 {
+		return 0, false, false
+	}
+	for a := 1; a < len(token)-1; a++ {
+		for b := a + 1; b < len(token); b++ {
+			if year, ok := dateFromParts(token[:a], token[a:b], token[b:]); ok {
+				return year, false, true
+			}
+		}
+	}
+	return 0, false, false
+}
+
+func dateFromParts(parts ...string) (int, bool) {
+	for _, p := range parts {
+		if len(p) == 3 || len(p) > 4 {
+			return 0, false
+		}
+	}
+	n := make([]int, len(parts))
+	for i, p := range parts {
+		n[i], _ = strconv.Atoi(p)
+	}
+	// The year is either the first or the last part.
+	for _, order := range [][3]int{{2, 1, 0}, {2, 0, 1}, {0, 1, 2}, {0, 2, 1}} {
+		y, m, d := n[order[0]], n[order[1]], n[order[2]]
+		if len(parts[order[1]]) > 2 || len(parts[order[2]]) > 2 {
+			continue
+		}
+		if len(parts[order[0]]) == 2 {
+			if y > 50 {
+				y += 1900
+			} else {
+				y += 2000
+			}
+		} else if len(parts[order[0]]) != 4 {
+			continue
+		}
+		if y < 1000 || y > 2050 || m < 1 || m > 12 || d < 1 || d > 31 {
+			continue
+		}
+		return y, true
+	}
+	return 0, false
+}
+
+func nCk(n, k int) float64 {
+	if k > n {
+		return 0
+	}
+	if k == 0 {
+		return 1
+	}
+	r := 1.0
+	for d := 1; d <= k; d++ {
+		r *= float64(n)
+		r /= float64(d)
+		n--
+	}
+	return r
+}
+
+func factorial(n int) float64 {
+	f := 1.0
+	for i := 2; i <= n; i++ {
+		f *= float64(i)
+	}
+	return f
+}
+
+// mostGuessableSequence finds the sequence of non-overlapping matches,
+// filling gaps with brute force, that minimizes the total number of guesses.
+func mostGuessableSequence(pass string, matches []Match) (float64, []Match) {
+	n := len(pass)
+	if n == 0 {
+		return 1, nil
+	}
+	byEnd := make([][]Match, n)
+	for _, m := range matches {
+		byEnd[m.j] = append(byEnd[m.j], m)
+	}
+
+	type entry struct {
+		m  Match
+		pi float64
+		g  float64
+	}
+	// optimal[k][l] is the best sequence of l matches covering pass[:k+1].
+	optimal := make([]map[int]entry, n)
+	for k := range optimal {
+		optimal[k] = map[int]entry{}
+	}
+
+	guessesFor := func(m Match) float64 {
+		g := m.Guesses
+		if len(m.Token) < n {
+			min := float64(minSubmatchGuessesMultiChar)
+			if len(m.Token) == 1 {
+				min = minSubmatchGuessesSingleChar
+			}
+			if g < min {
+				g = min
+			}
+		}
+		return g
+	}
+	update := func(m Match, l int) {
+		k := m.j
+		pi := guessesFor(m)
+		if l > 1 {
+			pi *= optimal[m.i-1][l-1].pi
+		}
+		g := factorial(l)*pi + math.Pow(minGuessesBeforeGrowingSequence, float64(l-1))
+		for competingL, competing := range optimal[k] {
+			if competingL > l {
+				continue
+			}
+			if competing.g <= g {
+				return
+			}
+		}
+		optimal[k][l] = entry{m: m, pi: pi, g: g}
+	}
+	bruteforce := func(i, j int) Match {
+		m := Match{
+			Pattern: PatternBruteforce,
+			Token:   pass[i : j+1],
+			i:       i,
+			j:       j,
+		}
+		m.Guesses = math.Pow(bruteforceCardinality, float64(j-i+1))
+		return m
+	}
+
+	for k := 0; k < n; k++ {
+		for _, m := range byEnd[k] {
+			if m.i > 0 {
+				for l := range optimal[m.i-1] {
+					update(m, l+1)
+				}
+			} else {
+				update(m, 1)
+			}
+		}
+		update(bruteforce(0, k), 1)
+		for i := 1; i <= k; i++ {
+			m := bruteforce(i, k)
+			for l, prev := range optimal[i-1] {
+				// Adjacent brute force matches are never optimal.
+				if prev.m.Pattern == PatternBruteforce {
+					continue
+				}
+				update(m, l+1)
+			}
+		}
+	}
+
+	bestL := 0
+	bestG := math.Inf(1)
+	for l, e := range optimal[n-1] {
+		if e.g < bestG || (e.g == bestG && l < bestL) {
+			bestL = l
+			bestG = e.g
+		}
+	}
+	seq := make([]Match, bestL)
+	k := n - 1
+	for l := bestL; l > 0; l-- {
+		m := optimal[k][l].m
+		seq[l-1] = m
+		k = m.i - 1
+	}
+	for i := range seq {
+		seq[i].Guesses = guessesFor(seq[i])
+	}
+	return bestG, seq
+}
+
+// PrintStrength lets the user know how guessable the password they
+// entered is.
+func PrintStrength(pass string, userInputs ...string) {
+	strength := EstimateStrength(pass, userInputs...)
+	fmt.Printf("Password strength: %s\n", strength)
+	if strength.Warning != "" {
+		fmt.Printf("Warning: %s\n", strength.Warning)
+	}
+}
+
+// String summarizes the strength for display to users.
+func (s Strength) String() string {
+	return fmt.Sprintf("%s (%d/4), estimated crack time: %s", s.ScoreName(), s.Score, s.DisplayCrackTime())
+}
@@ -0,0 +1,1496 @@
+package pc
+
+// Ranked frequency lists used by the password strength estimator. They are
+// derived from the zxcvbn project's frequency lists (MIT licensed) and are
+// ordered from most to least common.
+
+// passwordsList contains commonly used passwords.
+var passwordsList = `
+password 123456 12345678 1234 qwerty 12345 dragon pussy baseball football
+letmein monkey 696969 abc123 mustang shadow master 111111 2000 jordan
+superman harley 1234567 fuckme hunter fuckyou trustno1 ranger buster tigger
+soccer fuck batman test pass killer hockey charlie love sunshine asshole
+6969 pepper access 123456789 654321 maggie starwars silver dallas yankees
+123123 666666 hello orange biteme freedom computer sexy thunder ginger
+hammer summer corvette fucker austin 1111 merlin 121212 golfer cheese
+princess chelsea diamond yellow bigdog secret asdfgh sparky cowboy camaro
+matrix falcon iloveyou guitar purple scooter phoenix aaaaaa tigers porsche
+mickey maverick cookie nascar peanut 131313 money horny samantha panties
+steelers snoopy boomer whatever iceman smokey gateway dakota cowboys eagles
+chicken dick black zxcvbn ferrari knight hardcore compaq coffee booboo
+bitch bulldog xxxxxx welcome player ncc1701 wizard scooby junior internet
+bigdick brandy tennis blowjob banana monster spider lakers rabbit enter
+mercedes fender yamaha diablo boston tiger marine chicago rangers gandalf
+winter bigtits barney raiders porn badboy blowme spanky bigdaddy chester
+london midnight blue fishing 000000 hannah slayer 11111111 sexsex redsox
+thx1138 asdf marlboro panther zxcvbnm arsenal qazwsx mother 7777777 jasper
+winner golden butthead viking iwantu angels prince cameron girls madison
+hooters startrek captain maddog jasmine butter booger golf rocket theman
+liverpoo flower forever muffin turtle sophie redskins toyota sierra winston
+giants packers newyork casper bubba 112233 lovers mountain united driver
+helpme fucking pookie lucky maxwell 8675309 bear suckit gators 5150 222222
+shithead fuckoff jaguar hotdog tits gemini lover xxxxxxxx 777777 canada
+florida 88888888 rosebud metallic doctor trouble success stupid tomcat
+warrior peaches apples fish qwertyui magic buddy dolphins rainbow gunner
+987654 freddy alexis braves cock 2112 1212 cocacola xavier dolphin testing
+bond007 member voodoo 7777 samson apollo fire tester beavis voyager porno
+rush2112 beer apple scorpio skippy sydney red123 power beaver star jackass
+flyers boobs 232323 zzzzzz scorpion doggie legend ou812 yankee blazer
+runner birdie bitches 555555 topgun asdfasdf heaven viper animal 2222
+bigboy 4444 private godzilla lifehack phantom rock august sammy cool
+platinum jake bronco heka6w2 copper cumshot garfield willow cunt slut
+69696969 kitten super jordan23 eagle1 shelby america 11111 free 123321
+chevy bullshit broncos horney surfer nissan 999999 saturn airborne elephant
+shit action adidas qwert 1313 explorer police christin december wolf sweet
+therock online dickhead brooklyn cricket racing penis 0000 teens redwings
+dreams michigan hentai magnum 87654321 donkey trinity digital 333333
+cartman guinness 123abc speedy buffalo kitty pimpin eagle einstein nirvana
+vampire xxxx playboy pumpkin snowball test123 sucker mexico beatles fantasy
+celtic cherry cassie 888888 sniper genesis hotrod reddog alexande college
+jester passw0rd bigcock lasvegas slipknot 3333 death 1q2w3e eclipse
+1q2w3e4r drummer montana music aaaa carolina colorado creative hello1
+goober friday bollocks scotty abcdef bubbles hawaii fluffy horses thumper
+5555 pussies darkness asdfghjk boobies buddha sandman naughty honda azerty
+6666 shorty money1 beach loveme 4321 simple poohbear 444444 badass destiny
+vikings lizard assman nintendo 123qwe november xxxxx october leather
+bastard 101010 extreme password1 pussy1 lacrosse hotmail spooky amateur
+alaska badger paradise maryjane poop mozart video vagina spitfire cherokee
+cougar 420420 horse enigma raider brazil blonde 55555 dude drowssap lovely
+1qaz2wsx booty snickers nipples diesel rocks eminem westside suzuki passion
+hummer ladies alpha suckme 147147 pirate semperfi jupiter redrum freeuser
+wanker stinky ducati paris babygirl windows spirit pantera monday patches
+brutus smooth penguin marley forest cream 212121 flash maximus nipple
+vision pokemon champion fireman indian softball picard system cobra enjoy
+lucky1 boogie marines security dirty admin wildcats pimp dancer hardon
+fucked abcd1234 abcdefg ironman wolverin freepass bigred squirt justice
+hobbes pearljam mercury domino 9999 rascal hitman mistress bbbbbb peekaboo
+naked budlight electric sluts stargate saints bondage bigman zombie
+swimming duke qwerty1 babes scotland disney rooster mookie swordfis hunting
+blink182 8888 samsung bubba1 whore general passport aaaaaaaa erotic liberty
+arizona abcd newport skipper rolltide balls happy1 galore christ weasel
+242424 wombat digger classic bulldogs poopoo accord popcorn turkey bunny
+mouse 007007 titanic liverpool dreamer everton chevelle psycho nemesis
+pontiac connor eatme lickme cumming ireland spiderma patriots goblue devils
+empire asdfg cardinal shaggy froggy qwer kawasaki kodiak phpbb 54321
+chopper hooker whynot lesbian snake teen ncc1701d qqqqqq airplane britney
+avalon sugar sublime wildcat raven scarface elizabet 123654 trucks wolfpack
+pervert redhead american bambam woody shaved snowman tiger1 chicks raptor
+1969 stingray shooter france stars madmax sports 789456 simpsons lights
+chronic hahaha packard hendrix service spring srinivas spike 252525 bigmac
+suck single popeye tattoo texas bullet taurus sailor wolves panthers japan
+strike pussycat chris1 loverboy berlin sticky tarheels russia wolfgang
+testtest mature catch22 juice michael1 nigger 159753 alpha1 trooper hawkeye
+freaky dodgers pakistan machine pyramid vegeta katana moose tinker coyote
+infinity pepsi letmein1 bang hercules james1 tickle outlaw browns billybob
+pickle test1 sucks pavilion changeme caesar prelude darkside bowling wutang
+sunset alabama danger zeppelin pppppp 2001 ping darkstar madonna qwe123
+bigone casino charlie1 mmmmmm integra wrangler apache tweety qwerty12
+bobafett transam 2323 seattle ssssss openup pandora pussys trucker indigo
+storm malibu weed review babydoll doggy dilbert pegasus joker catfish
+flipper fuckit detroit cheyenne bruins smoke marino fetish xfiles stinger
+pizza babe stealth manutd gundam cessna longhorn presario mnbvcxz wicked
+mustang1 victory 21122112 awesome athena q1w2e3r4 holiday knicks redneck
+12341234 gizmo scully dragon1 devildog triumph bluebird shotgun peewee
+angel1 metallica madman impala lennon omega access14 enterpri search smitty
+blizzard unicorn tight asdf1234 trigger truck beauty thailand 1234567890
+cadillac castle bobcat buddy1 sunny stones asian butt loveyou hellfire
+hotsex indiana panzer lonewolf trumpet colors blaster 12121212 fireball
+precious jungle atlanta gold corona polaris timber theone baller chipper
+skyline dragons dogs licker engineer kong pencil basketba hornet barbie
+wetpussy indians redman foobar travel morpheus target 141414 hotstuff
+photos rocky1 fuck_inside dollar turbo design hottie 202020 blondes 4128
+lestat avatar goforit random abgrtyu jjjjjj cancer q1w2e3 smiley express
+virgin zipper wrinkle1 babylon consumer monkey1 serenity samurai 99999999
+bigboobs skeeter joejoe master1 aaaaa chocolat christia stephani tang
+1234qwer 98765432 sexual maxima 77777777 buckeye highland seminole reaper
+bassman nugget lucifer airforce nasty warlock 2121 dodge chrissy burger
+snatch pink gang maddie huskers piglet photo dodger paladin chubby buckeyes
+hamlet abcdefgh bigfoot sunday manson goldfish garden deftones icecream
+blondie spartan charger stormy juventus galaxy escort zxcvb planet blues
+david1 ncc1701e 1966 51505150 cavalier gambit ripper oicu812 nylons
+aardvark whiskey bing plastic anal babylon5 loser racecar insane yankees1
+mememe hansolo chiefs fredfred freak frog salmon concrete zxcv shamrock
+atlantis wordpass rommel 1010 predator massive cats sammy1 mister stud
+marathon rubber ding trunks desire montreal justme faster irish 1999
+jessica1 alpine diamonds 00000 swinger shan stallion pitbull letmein2 ming
+shadow1 clitoris fuckers jackoff bluesky sundance renegade hollywoo 151515
+wolfman soldier ling goddess manager sweety titans fang ficken niners
+bubble hello123 ibanez sweetpea stocking 323232 tornado content aragorn
+trojan christop rockstar geronimo pascal crimson google fatcat lovelove
+cunts stimpy finger wheels viper1 latin greenday 987654321 creampie hiphop
+snapper funtime duck trombone adult cookies mulder westham latino jeep
+ravens drizzt madness energy kinky 314159 slick rocker 55555555 mongoose
+speed dddddd catdog cheng ghost gogogo tottenha curious butterfl mission
+january shark techno lancer lalala chichi orion trixie delta bobbob bomber
+kang 1968 spunky liquid beagle granny network kkkkkk 1973 biggie beetle
+teacher toronto anakin genius cocks dang karate snakes bangkok fuckyou2
+pacific daytona infantry skywalke sailing raistlin vanhalen huang blackie
+tarzan strider sherlock gong dietcoke ultimate shai sprite ting artist chai
+chao devil python ninja ytrewq superfly 456789 tian jing jesus1 freedom1
+drpepper chou hobbit shen nolimit mylove biscuit yahoo shasta sex4me smoker
+pebbles pics philly tong tintin lesbians cactus frank1 tttttt chun danni
+emerald showme pirates lian dogg xiao xian tazman tanker toshiba gotcha
+rang keng jazz bigguy yuan tomtom chaos fossil racerx creamy bobo musicman
+warcraft blade shuang shun lick jian microsoft rong feng getsome quality
+1977 beng wwwwww yoyoyo zhang seng harder qazxsw qian cong chuan deng nang
+boeing keeper western 1963 subaru sheng thuglife teng jiong miao mang
+maniac pussie a1b2c3 zhou zhuang xing stonecol spyder liang jiang memphis
+ceng magic1 logitech chuang sesame shao poison titty kuan kuai mian guan
+hamster guai ferret geng duan pang maiden quan velvet nong neng nookie
+buttons bian bingo biao zhong zeng zhun ying zong xuan zang 0.0.000 suan
+shei shui sharks shang shua peng pian piao liao meng miami reng guang cang
+ruan diao luan qing chui chuo cuan nuan ning heng huan kansas muscle weng
+1passwor bluemoon zhui zhua xiang zheng zhen zhei zhao zhan yomama zhai
+zhuo zuan tarheel shou shuo tiao leng kuang jiao 13579 basket qiao qiong
+qiang chuai nian niao niang huai 22222222 zhuan zhuai shuan shuai stardust
+jumper 66666666 charlott qwertz bones waterloo 2002 11223344 oldman trains
+vertigo 246810 black1 swallow smiles standard alexandr parrot user 1976
+surfing pioneer apple1 asdasd auburn hannibal frontier panama welcome1
+vette blue22 shemale 111222 baggins groovy global 181818 1979 blades
+spanking byteme lobster dawg japanese 1970 1964 2424 polo coco deedee mikey
+1972 171717 1701 strip jersey green1 capital putter vader seven7 banshee
+grendel dicks hidden iloveu 1980 ledzep 147258 female bugger buffett molson
+2020 wookie sprint jericho 102030 ranger1 trebor deepthroat bonehead molly1
+mirage models 1984 2468 showtime squirrel pentium anime gator powder
+twister connect neptune engine eatshit mustangs woody1 shogun septembe pooh
+jimbo russian sabine voyeur 2525 363636 camel germany giant qqqq nudist
+bone sleepy tequila fighter obiwan makaveli vacation walnut 1974 ladybug
+cantona ccbill satan rusty1 passwor1 columbia kissme motorola william1 1967
+zzzz skater smut matthew1 valley coolio dagger boner bull horndog jason1
+penguins rescue griffey 8j4ye3uz californ champs qwertyuiop portland colt45
+xxxxxxx xanadu tacoma carpet gggggg safety palace italia picturs picasso
+thongs tempest asd123 hairy foxtrot nimrod hotboy 343434 1111111 asdfghjkl
+goose overlord stranger 454545 shaolin sooners socrates spiderman peanuts
+13131313 andrew1 filthy ohyeah africa intrepid pickles assass fright potato
+hhhhhh kingdom weezer 424242 pepsi1 throat looker puppy butch sweets
+megadeth analsex nymets ddddddd bigballs oakland oooooo qweasd chucky
+carrot chargers discover dookie condor horny1 sunrise sinner jojo megapass
+martini assfuck ffffff mushroom jamaica 7654321 77777 cccccc gizmodo
+tractor mypass hongkong 1975 blue123 pissing thomas1 redred basketball
+satan666 dublin bollox kingkong 1971 22222 272727 sexx bbbb grizzly passat
+defiant bowler knickers monitor wisdom slappy thor letsgo robert1 brownie
+098765 playtime lightnin atomic goku llllll qwaszx cosmos bosco knights
+beast slapshot assword frosty dumbass mallard dddd 159357 titleist aussie
+golfing doobie loveit werewolf vipers 1965 blabla surf sucking tardis
+thegame legion rebels sarah1 onelove loulou toto blackcat 0007 tacobell
+soccer1 jedi method poopie boob breast kittycat belly pikachu thunder1
+thankyou celtics frogger scoobydo sabbath coltrane budman jackal zzzzz
+licking gopher geheim lonestar primus pooper newpass brasil heather1 husker
+element moomoo beefcake zzzzzzzz shitty smokin jjjj anthony1 anubis backup
+gorilla fuckface lowrider punkrock traffic delta1 amazon fatass dodgeram
+dingdong qqqqqqqq breasts boots honda1 spidey poker temp johnjohn 147852
+asshole1 dogdog tricky crusader syracuse spankme speaker meridian amadeus
+harley1 falcons turkey50 kenwood keyboard ilovesex 1978 shazam shalom
+lickit jimbob roller fatman sandiego magnus cooldude clover mobile plumber
+texas1 tool topper mariners rebel caliente celica oxford osiris orgasm
+punkin porsche9 tuesday breeze bossman kangaroo latinas astros scruffy
+qwertyu hearts jammer java 1122 goodtime chelsea1 freckles flyboy doodle
+nebraska bootie kicker webmaster vulcan 191919 blueeyes 321321 farside
+rugby director pussy69 power1 hershey hermes monopoly birdman blessed
+blackjac southern peterpan thumbs fuckyou1 rrrrrr a1b2c3d4 coke bohica
+elvis1 blacky sentinel snake1 richard1 1234abcd guardian candyman fisting
+scarlet dildo pancho mandingo lucky7 condom munchkin billyboy summer1 sword
+skiing site sony thong rootbeer assassin fffff fitness durango postal
+achilles kisses warriors plymouth topdog asterix hallo cameltoe fuckfuck
+eeeeee sithlord theking avenger backdoor chevrole trance cosworth houses
+homers eternity kingpin verbatim incubus 1961 blond zaphod shiloh spurs
+mighty aliens charly dogman omega1 printer aggies deadhead bitch1 stone55
+pineappl thekid rockets camels formula oracle pussey porkchop abcde clancy
+mystic inferno blackdog steve1 alfa grumpy flames puffy proxy valhalla
+unreal herbie engage yyyyyy 010101 pistol celeb gggg portugal a12345 newbie
+mmmm 1qazxsw2 zorro writer stripper sebastia spread links metal 1221 565656
+funfun trojans cyber hurrican moneys 1x2zkg8w zeus tomato lion atlantic
+usa123 trans aaaaaaa homerun hyperion kevin1 blacks 44444444 skittles fart
+gangbang fubar sailboat oilers buster1 hithere immortal sticks pilot
+lexmark jerkoff maryland cheers possum cutter muppet swordfish sport sonic
+peter1 jethro rockon asdfghj pass123 pornos ncc1701a bootys buttman bonjour
+1960 bears 362436 spartans tinman threesom maxmax 1414 bbbbb camelot chewie
+gogo fusion saint dilligaf nopass hustler hunter1 whitey beast1 yesyes
+spank smudge pinkfloy patriot lespaul hammers formula1 sausage scooter1
+orioles oscar1 colombia cramps exotic iguana suckers slave topcat lancelot
+magelan racer crunch british steph 456123 skinny seeking rockhard filter
+freaks sakura pacman poontang newlife homer1 klingon watcher walleye tasty
+sinatra starship steel starbuck poncho amber1 gonzo catherin candle firefly
+goblin scotch diver usmc huskies kentucky kitkat beckham bicycle yourmom
+studio 33333333 splash jimmy1 12344321 sapphire mailman raiders1 ddddd
+excalibu illini imperial lansing maxx gothic golfball facial front242
+macdaddy qwer1234 vectra cowboys1 crazy1 dannyboy aquarius franky ffff
+sassy pppp pppppppp prodigy noodle eatpussy vortex wanking billy1 siemens
+phillies groups chevy1 cccc gggggggg doughboy dracula nurses loco lollipop
+utopia chrono cooler nevada wibble summit 1225 capone fugazi panda qazwsxed
+puppies triton 9876 nnnnnn momoney iforgot wolfie studly hamburg 81fukkc
+741852 catman china gagging scott1 oregon qweqwe crazybab daniel1 cutlass
+holes mothers music1 walrus 1957 bigtime xtreme simba ssss rookie bathing
+rotten maestro turbo1 99999 butthole hhhh yoda shania phish thecat rightnow
+baddog greatone gateway1 abstr napster brian1 bogart hitler wildfire
+jackson1 1981 beaner yoyo 0.0.0.000 super1 select snuggles slutty phoenix1
+technics toon raven1 rayray 123789 1066 albion greens gesperrt brucelee
+hehehe kelly1 mojo 1998 bikini woofwoof yyyy strap sites central f**k
+nyjets punisher username vanilla twisted bunghole viagra veritas pony titts
+labtec jenny1 masterbate mayhem redbull govols gremlin 505050 gmoney rovers
+diamond1 trident abnormal deskjet cuddles bristol milano vh5150 jarhead
+1982 bigbird bizkit sixers slider star69 starfish penetration tommy1
+john316 caligula flicks films railroad cosmo cthulhu br0d3r bearbear
+swedish spawn patrick1 reds anarchy groove fuckher oooo airbus cobra1 clips
+delete duster kitty1 mouse1 monkeys jazzman 1919 262626 swinging stroke
+stocks sting pippen labrador jordan1 justdoit meatball females vector
+cooter defender nike bubbas bonkers kahuna wildman 4121 sirius static
+piercing terror teenage leelee microsof mechanic robotech rated chaser
+salsero macross quantum tsunami daddy1 cruise newpass6 nudes hellyeah 1959
+zaq12wsx striker spice spectrum smegma thumb jjjjjjjj mellow cancun cartoon
+sabres samiam oranges oklahoma lust denali nude noodles brest hooter
+mmmmmmmm warthog blueblue zappa wolverine sniffing jjjjj calico freee rover
+pooter closeup bonsai emily1 keystone iiii 1955 yzerman theboss tolkien
+megaman rasta bbbbbbbb hal9000 goofy gringo gofish gizmo1 samsam scuba
+onlyme tttttttt corrado clown clapton bulls jayhawk wwww sharky seeker
+ssssssss pillow thesims lighter lkjhgf melissa1 marcius2 guiness gymnast
+casey1 goalie godsmack lolo rangers1 poppy clemson clipper deeznuts holly1
+eeee kingston yosemite sucked sex123 sexy69 pic's tommyboy masterbating
+gretzky happyday frisco orchid orange1 manchest aberdeen ne1469 boxing korn
+intercourse 161616 1985 ziggy supersta stoney amature babyboy bcfields
+goliath hack hardrock frodo scout scrappy qazqaz tracker active craving
+commando cohiba cyclone bubba69 katie1 mpegs vsegda irish1 sexy1 smelly
+squerting lions jokers jojojo meathead ashley1 groucho cheetah champ
+firefox gandalf1 packer love69 tyler1 typhoon tundra bobby1 kenworth
+village volley wolf359 0420 000007 swimmer skydive smokes peugeot pompey
+legolas redhot rodman redalert grapes 4runner carrera floppy ou8122 quattro
+cloud9 davids nofear busty homemade mmmmm whisper vermont webmaste wives
+insertion jayjay philips topher temptress midget ripken havefun canon
+celebrity ghetto ragnarok usnavy conover cruiser dalshe nicole1 buzzard
+hottest kingfish misfit milfnew warlord wassup bigsexy blackhaw zippy
+tights kungfu labia meatloaf area51 batman1 bananas 636363 ggggg paradox
+queens adults aikido cigars hoosier eeyore moose1 warez interacial
+streaming 313131 pertinant pool6123 mayday animated banker baddest gordon24
+ccccc fantasies aisan deadman homepage ejaculation whocares iscool jamesbon
+1956 1pussy womam sweden skidoo spock sssss pepper1 pinhead micron allsop
+amsterda gunnar 666999 february fletch george1 sapper sasha1 luckydog
+lover1 magick popopo ultima cypress businessbabe brandon1 vulva vvvv
+jabroni bigbear yummy 010203 searay secret1 sinbad sexxxx soleil software
+piccolo thirteen leopard legacy memorex redwing rasputin 134679 anfield
+greenbay catcat feather scanner pa55word contortionist danzig daisy1 hores
+exodus iiiiii 1001 subway snapple sneakers sonyfuck picks poodle test1234
+llll junebug marker mellon ronaldo roadkill amanda1 asdfjkl beaches great1
+cheerleaers doitnow ozzy boxster brighton housewifes kkkk mnbvcx moocow
+vides 1717 bigmoney blonds 1000 storys stereo 4545 420247 seductive
+sexygirl lesbean justin1 124578 cabbage canadian gangbanged dodge1 dimas
+malaka puss probes coolman nacked hotpussy erotica kool implants intruder
+bigass zenith woohoo womans tango pisces laguna maxell andyod22 barcelon
+chainsaw chickens flash1 orgasms magicman profit pusyy pothead coconut
+chuckie clevelan builder budweise hotshot horizon experienced mondeo wifes
+1962 stumpy smiths slacker pitchers passwords laptop allmine alliance
+bbbbbbb asscock halflife 88888 chacha saratoga sandy1 doogie qwert40
+transexual close-up ib6ub9 volvo jacob1 iiiii beastie sunnyday stoned
+sonics starfire snapon pictuers pepe testing1 tiberius lisalisa lesbain
+litle retard ripple austin1 badgirl golfgolf flounder royals dragoon dickie
+passwor majestic poppop trailers nokia bobobo br549 minime mikemike
+whitesox 1954 3232 353535 seamus solo sluttey pictere titten lback 1024
+goodluck fingerig gallaries goat passme oasis lockerroom logan1 rainman
+treasure custom cyclops nipper bucket homepage- hhhhh momsuck indain 2345
+beerbeer bimmer stunner 456456 tootsie testerer reefer 1012 harcore gollum
+545454 chico caveman fordf150 fishes gaymen saleen doodoo pa55w0rd presto
+qqqqq cigar bogey helloo dutch kamikaze wasser vietnam visa japanees 0123
+swords slapper peach masterbaiting redwood 1005 ametuer chiks fucing sadie1
+panasoni mamas rambo unknown absolut dallas1 housewife keywest kipper
+18436572 1515 zxczxc 303030 shaman terrapin masturbation mick redfish 1492
+angus goirish hardcock forfun galary freeporn duchess olivier lotus
+pornographic ramses purdue traveler crave brando enter1 killme moneyman
+welder windsor wifey indon yyyyy taylor1 4417 picher pickup thumbnils
+johnboy jets ameteur amateurs apollo13 hambone goldwing 5050 sally1
+doghouse padres pounding quest truelove underdog trader climber bolitas
+hohoho beanie beretta wrestlin stroker sexyman jewels johannes mets rhino
+bdsm balloons grils happy123 flamingo route66 devo outkast paintbal magpie
+llllllll twilight critter cupcake nickel bullseye knickerless videoes
+binladen xerxes slim slinky pinky thanatos meister menace retired albatros
+balloon goten 5551212 getsdown donuts nwo4life tttt comet deer dddddddd
+deeznutz nasty1 nonono enterprise eeeee misfit99 milkman vvvvvv 1818
+blueboy bigbutt tech toolman juggalo jetski barefoot 50spanks gobears
+scandinavian cubbies nitram kings bilbo yumyum zzzzzzz stylus 321654
+shannon1 server squash starman steeler phrases techniques laser 135790
+athens cbr600 chemical fester gangsta fucku2 droopy objects passwd lllll
+manchester vedder clit chunky darkman buckshot buddah boobed henti winter1
+bigmike beta zidane talon
+`
+
+// englishList contains common English words.
+var englishList = `
+you i to the a and that it of me what is in this know i'm for no have my
+don't just not do be on your was we it's with so but all well are he oh
+about right you're get here out going like yeah if her she can up want
+think that's now go him at how got there one did why see come good they
+really as would look when time will okay back can't mean tell i'll from hey
+were he's could didn't yes his been or something who because some had then
+say ok take an way us little make need gonna never we're too she's i've
+sure them more over our sorry where what's let thing am maybe down man has
+uh very by there's should anything said much any life even off doing thank
+give only thought help two talk people god still wait into find nothing
+again things let's doesn't call told great before better ever night than
+away first believe other feel everything work you've fine home after last
+these day keep does put around stop they're i'd guy isn't always listen
+wanted mr guys huh those big lot happened thanks won't trying kind wrong
+through talking made new being guess hi care bad mom remember getting we'll
+together dad leave place understand wouldn't actually hear baby nice father
+else stay done wasn't their course might mind every enough try hell came
+someone you'll own family whole another house yourself idea ask best must
+coming old looking woman which years room left knew tonight real son hope
+name same went um hmm happy pretty saw girl sir show friend already saying
+next three job problem minute found world thinking haven't heard honey
+matter myself couldn't exactly having ah probably happen we've hurt boy
+both while dead gotta alone since excuse start kill hard you'd today car
+ready until without wants hold wanna yet seen deal took once gone called
+morning supposed friends head stuff most used worry second part live truth
+school face forget true business each cause soon knows few telling wife
+who's use chance run move anyone person bye somebody dr heart such miss
+married point later making meet anyway many phone reason damn lost looks
+bring case turn wish tomorrow kids trust check change end late anymore five
+least town aren't ha working year makes taking means brother play hate ago
+says beautiful gave fact crazy party sit open afraid between important rest
+fun kid word watch glad everyone days sister minutes everybody bit couple
+whoa either mrs feeling daughter wow gets asked under break promise door
+set close hand easy question tried far walk needs mine though times
+different killed hospital anybody alright wedding shut able die perfect
+stand comes hit story ya mm waiting dinner against funny husband almost pay
+answer four office eyes news child shouldn't half side yours moment sleep
+read where's started men sounds sonny pick sometimes em bed also date line
+plan hours lose hands serious behind inside high ahead week wonderful fight
+past cut quite number he'll sick it'll game eat nobody goes along save
+seems finally lives worried upset carly met book brought seem sort safe
+living children weren't leaving front shot loved asking running clear
+figure hot felt six parents drink absolutely how's daddy alive sense meant
+happens special bet blood ain't kidding lie full meeting dear seeing sound
+fault water ten women buy months hour speak lady jen thinks christmas body
+order outside hang possible worse company mistake ooh handle spend totally
+giving control here's marriage realize president unless sex send needed
+taken died scared picture talked ass hundred changed completely explain
+playing certainly sign boys relationship loves hair lying choice anywhere
+future weird luck she'll turned known touch kiss crane questions obviously
+wonder pain calling somewhere throw straight cold fast words food none
+drive feelings they'll worked marry light drop cannot sent city dream
+protect twenty class surprise its sweetheart poor looked mad except gun
+y'know dance takes appreciate especially situation besides pull himself
+hasn't act worth sheridan amazing top given expect rather involved swear
+piece busy law decided happening movie we'd catch country less perhaps step
+fall watching kept darling dog win air honor personal moving till admit
+problems murder he'd evil definitely feels information honest eye broke
+missed longer dollars tired evening human starting red entire trip club
+niles suppose calm imagine fair caught blame street sitting favor apartment
+court terrible clean learn works frasier relax million accident wake prove
+smart message missing forgot interested table nbsp become mouth pregnant
+middle ring careful shall team ride figured wear shoot stick follow angry
+instead write stopped early ran war standing forgive jail wearing kinda
+lunch cristian eight greenlee gotten hoping phoebe thousand ridge paper
+tough tape state count boyfriend proud agree birthday seven they've history
+share offer hurry feet wondering decision building ones finish voice
+herself would've list mess deserve evidence cute dress interesting hotel
+quiet concerned road staying beat sweetie mention clothes finished fell
+neither mmm fix respect spent prison attention holding calls near surprised
+bar keeping gift hadn't putting dark self owe using ice helping normal aunt
+lawyer apart certain plans jax girlfriend floor whether everything's
+present earth box cover judge upstairs sake mommy possibly worst station
+acting accept blow strange saved conversation plane mama yesterday lied
+quick lately stuck report difference rid store she'd bag bought doubt
+listening walking cops deep dangerous buffy sleeping chloe rafe shh record
+lord moved join card crime gentlemen willing window return walked guilty
+likes fighting difficult soul joke favorite uncle promised public bother
+island seriously cell lead knowing broken advice somehow paid losing push
+helped killing usually earlier boss beginning liked innocent doc rules cop
+learned thirty risk letting speaking officer ridiculous support afternoon
+born apologize seat nervous across song charge patient boat how'd hide
+detective planning nine huge breakfast horrible age awful pleasure driving
+hanging picked sell quit apparently dying notice congratulations chief
+one's month visit could've c'mon letter decide double sad press forward
+fool showed smell seemed spell memory pictures slow seconds hungry board
+position hearing roz kitchen ma'am force fly during space should've
+realized experience kick others grab mother's discuss third cat fifty
+responsible fat reading idiot yep suddenly agent destroy bucks track shoes
+scene peace arms demon low livvie consider papers medical incredible witch
+drunk attorney tells knock ways gives department nose skye turns keeps
+jealous drug sooner cares plenty extra tea won attack ground whose outta
+weekend matters wrote type father's gosh opportunity impossible books waste
+pretend named jump eating proof complete slept career arrest breathe
+perfectly warm pulled twice easier goin dating suit romantic drugs
+comfortable finds checked fit divorce begin ourselves closer ruin although
+smile laugh treat god's fear what'd guy's otherwise excited mail hiding
+cost stole pacey noticed fired excellent lived bringing pop bottom note
+sudden bathroom flight honestly sing foot games remind bank charges witness
+finding places tree dare hardly that'll interest steal silly contact teach
+shop plus colonel fresh trial invited roll radio reach heh choose emergency
+dropped credit obvious cry locked loving positive nuts agreed prue goodbye
+condition guard fuckin grow cake mood dad's total crap crying belong lay
+partner trick pressure ohh arm dressed cup lies bus taste neck south
+something's nurse raise lots carry group whoever drinking they'd breaking
+file lock wine closed writing spot paying study assume asleep man's turning
+legal viki bedroom shower nikolas camera fill reasons forty bigger nope
+breath doctors pants level movies gee area folks ugh continue focus wild
+truly desk convince client threw band hurts spending allow grand answers
+shirt chair allowed rough doin sees government ought empty round hat wind
+shows aware dealing pack meaning hurting ship subject guest mom's pal match
+arrested salem confused surgery expecting deacon unfortunately goddamn lab
+passed bottle beyond whenever pool opinion held common starts jerk secrets
+falling played necessary barely dancing health tests copy cousin planned
+dry ahem twelve simply tess skin often fifteen speech names issue orders
+nah final results code believed complicated umm research nowhere escape
+biggest restaurant grateful usual burn address within someplace screw
+everywhere train film regret goodness mistakes details responsibility
+suspect corner hero dumb terrific further gas whoo hole memories o'clock
+following ended nobody's teeth ruined split airport bite stenbeck older
+liar showing project cards desperate themselves pathetic damage spoke
+quickly scare marah afford vote settle mentioned due stayed rule checking
+tie hired upon heads concern blew natural alcazar champagne connection
+tickets happiness form saving kissing hated personally suggest prepared
+build leg onto leaves downstairs ticket it'd taught loose holy staff sea
+duty convinced throwing defense kissed legs according loud practice
+saturday babies army where'd warning miracle carrying flying blind ugly
+shopping hates someone's sight bride coat account states clearly celebrate
+brilliant wanting add forrester lips custody center screwed buying size
+toast thoughts student stories however professional reality birth lexie
+attitude advantage grandfather sami sold opened grandma beg changes someday
+grade roof brothers signed ahh marrying powerful grown grandmother fake
+opening expected eventually must've ideas exciting covered familiar bomb
+bout television harmony color heavy schedule records capable practically
+including correct clue forgotten immediately appointment social nature
+deserves threat bloody lonely ordered shame local jacket hook destroyed
+scary investigation above invite shooting port lesson criminal growing
+caused victim professor followed funeral nothing's considering burning
+strength loss view gia sisters everybody's several pushed written
+somebody's shock pushing heat chocolate greatest miserable corinthos
+nightmare brings zander character became famous enemy crash chances sending
+recognize healthy boring feed engaged percent headed lines treated purpose
+knife rights drag san fan badly hire paint pardon built behavior closet
+warn gorgeous milk survive forced operation offered ends dump rent
+remembered lieutenant trade thanksgiving rain revenge physical available
+program prefer baby's spare pray disappeared aside statement sometime meat
+fantastic breathing laughing itself tip stood market affair ours depends
+main protecting jury national brave large jack's interview fingers murdered
+explanation process picking based style pieces blah assistant stronger aah
+pie handsome unbelievable anytime nearly shake everyone's oakdale cars
+wherever serve pulling points medicine facts waited lousy circumstances
+stage disappointed weak trusted license nothin community trash
+understanding slip cab sounded awake friendship stomach weapon threatened
+mystery official regular river vegas understood contract race basically
+switch frankly issues cheap lifetime deny painting ear clock weight garbage
+why'd tear ears dig selling setting indeed changing singing tiny particular
+draw decent avoid messed filled touched score people's disappear exact
+pills kicked harm recently fortune pretending raised insurance fancy drove
+cared belongs nights shape lorelai base lift stock sonny's fashion timing
+guarantee chest bridge woke source patients theory original burned watched
+heading selfish oil drinks failed period doll committed elevator freeze
+noise exist science pair edge wasting sat ceremony pig uncomfortable peg
+guns staring files bike weather name's mostly stress permission arrived
+thrown possibility example borrow release ate notes hoo library property
+negative fabulous event doors screaming xander term what're meal fellow
+apology anger honeymoon wet bail parking non protection fixed families
+chinese campaign map wash stolen sensitive stealing chose lets comfort
+worrying whom pocket mateo bleeding students shoulder ignore fourth
+neighborhood fbi talent tied garage dies demons dumped witches training
+rude crack model bothering radar grew remain soft meantime gimme connected
+kinds cast sky likely fate buried hug brother's concentrate prom messages
+east unit intend crew ashamed somethin manage guilt weapons terms interrupt
+guts tongue distance conference treatment shoe basement sentence purse
+glasses cabin universe towards repeat mirror wound travers tall reaction
+odd engagement therapy letters emotional runs magazine jeez decisions soup
+daughter's thrilled society managed stake chef moves extremely entirely
+moments expensive counting shots kidnapped square son's cleaning shift
+plate impressed smells trapped male tour aidan knocked charming attractive
+argue puts whip language embarrassed settled package laid animals hitting
+disease bust stairs alarm pure nail nerve incredibly walks dirt stamp
+sister's becoming terribly friendly easily damned jobs suffering disgusting
+stopping deliver riding helps federal disaster bars dna crossed rate create
+trap claim california talks eggs effect chick threatening spoken introduce
+confession embarrassing bags impression gate year's reputation attacked
+among knowledge presents inn europe chat suffer argument talkin crowd
+homework fought coincidence cancel accepted rip pride solve hopefully
+pounds pine mate illegal generous streets con separate outfit maid bath
+punch mayor freaked begging recall enjoying bug woman's prepare parts wheel
+signal direction defend signs painful yourselves rat maris amount that'd
+suspicious flat cooking button warned sixty pity parties crisis coach row
+yelling leads awhile pen confidence offering falls image farm pleased panic
+hers gettin role refuse determined hell's grandpa progress testify passing
+military choices uhh gym cruel wings bodies mental gentleman coma cutting
+proteus guests girl's expert benefit faces cases led jumped toilet
+secretary sneak mix firm halloween agreement privacy dates anniversary
+smoking reminds pot created twins swing successful season scream considered
+solid options commitment senior ill else's crush ambulance wallet
+discovered officially til rise reached eleven option laundry former assure
+stays skip fail accused wide challenge popular learning discussion clinic
+plant exchange betrayed bro sticking university members lower bored mansion
+soda sheriff suite handled busted senator load happier younger studying
+romance procedure ocean section sec commit assignment suicide minds swim
+ending bat yell llanview league chasing seats proper command believes humor
+hopes fifth winning solution leader theresa's sale lawyers nor material
+latest highly escaped audience parent tricks insist dropping cheer
+medication higher flesh district routine century shared sandwich handed
+false beating appear warrant family's awfully odds article treating thin
+suggesting fever sweat silent specific clever sweater request prize mall
+tries mile fully estate union sharing assuming judgment goodnight divorced
+despite surely steps jet confess math listened comin answered vulnerable
+bless dreaming rooms chip zero potential pissed nate kills tears knees
+chill carly's brains agency harvard degree unusual wife's joint packed
+dreamed cure covering newspaper lookin coast grave egg direct cheating
+breaks quarter mixed locker husband's gifts awkward toy thursday rare
+policy kid's joking competition classes assumed reasonable dozen curse
+quartermaine millions dessert rolling detail alien served delicious closing
+vampires released ancient wore value tail secure salad murderer hits toward
+spit screen offense dust conscience bread answering admitted lame
+invitation grief smiling path stands bowl pregnancy hollywood prisoner
+delivery guards virus shrink influence freezing concert wreck partners
+massimo chain birds life's wire technically presence blown anxious cave
+version holidays cleared wishes survived caring candles bound related charm
+yup pulse jumping jokes frame boom vice performance occasion silence opera
+nonsense frightened downtown americans slipped dimera blowing world's
+session relationships kidnapping actual spin civil roxy packing education
+blaming wrap obsessed fruit torture personality location effort daddy's
+commander trees there'll owner fairy per other's necessarily county contest
+seventy print motel fallen directly underwear grams exhausted believing
+particularly freaking carefully trace touching messing committee recovery
+intention consequences belt sacrifice courage officers enjoyed lack
+attracted appears bay yard returned remove nut carried today's testimony
+intense granted violence heal defending attempt unfair relieved political
+loyal approach slowly plays normally buzz alcohol actor surprises
+psychiatrist pre plain attic who'd uniform terrified sons pet cleaned zach
+threaten teaching mum motion fella enemies desert collection incident
+failure satisfied imagination hooked headache forgetting counselor andie
+acted opposite highest equipment badge italian visiting naturally frozen
+commissioner sakes labor appropriate trunk armed thousands received dunno
+costume temporary sixteen impressive zone kicking junk hon grabbed unlike
+understands describe clients owns affect witnesses starving instincts
+happily discussing deserved strangers leading intelligence host authority
+surveillance cow commercial admire questioning fund dragged barn object
+deeply amp wrapped wasted tense route reports hoped fellas election
+roommate mortal fascinating chosen stops shown arranged abandoned sides
+delivered becomes arrangements agenda began theater series literally
+propose honesty underneath forces services sauce promises lecture eighty
+torn shocked relief explained counter circle victims transfer response
+channel identity differently campus spy ninety interests guide deck
+biological pheebs ease creep will's waitress skills telephone ripped
+raising scratch rings prints wave thee arguing figures ephram asks
+reception pin oops diner annoying agents taggert goal mass ability sergeant
+julian's international gig blast basic tradition towel earned rub
+president's habit customers creature bermuda actions snap react prime
+paranoid wha handling eaten therapist comment charged tax sink reporter
+beats priority interrupting gain fed warehouse shy pattern loyalty
+inspector events pleasant media excuses threats permanent guessing
+financial demand assault tend praying motive los unconscious trained museum
+tracks range nap mysterious unhappy tone switched rappaport award sookie
+neighbor loaded gut childhood causing swore piss hundreds balance
+background toss mob misery valentine's thief squeeze lobby hah goa'uld geez
+exercise ego drama al's forth facing booked boo songs sandburg eighteen
+d'you bury perform everyday digging creepy compared wondered trail liver
+hmmm drawn device magical journey fits discussed supply moral helpful
+attached timmy's searching flew depressed aisle underground pro daughters
+cris amen vows proposal pit neighbors darn cents arrange annulment uses
+useless squad represent product joined afterwards adventure resist
+protected net fourteen celebrating piano inch flag debt violent tag sand
+gum dammit teal'c hip celebration below reminded claims tonight's replace
+phones paperwork emotions typical stubborn stable sheridan's pound papa lap
+designed current bum tension tank suffered steady provide overnight
+meanwhile chips beef wins suits boxes salt cassadine collect boy's tragedy
+therefore spoil realm profile degrees wipe surgeon stretch stepped nephew
+neat limo confident anti perspective designer climb title suggested
+punishment finest ethan's springfield occurred hint furniture blanket twist
+surrounded surface proceed lip fries worries refused niece gloves soap
+signature disappoint crawl convicted zoo result pages lit flip counsel
+doubts crimes accusing when's shaking remembering phase hallway halfway
+bothered useful makeup madam gather concerns cia cameras blackmail symptoms
+rope ordinary imagined concept cigarette supportive memorial explosion yay
+woo trauma ouch leo's furious cheat avoiding whew thick oooh boarding
+approve urgent shhh misunderstanding minister drawer sin phony joining jam
+interfere governor chapter catching bargain tragic schools respond punish
+penthouse hop thou remains rach ohhh insult doctor's bugs beside begged
+absolute strictly stefano socks senses ups sneaking yah serving reward
+polite checks tale physically instructions fooled blows tabby internal
+bitter adorable y'all tested suggestion string jewelry debate com alike
+pitch fax distracted shelter lessons foreign average twin friend's damnit
+constable circus audition tune shoulders mud mask helpless feeding explains
+dated robbery objection behave valuable shadows courtroom confusing tub
+talented struck smarter mistaken italy customer bizarre scaring punk
+motherfucker holds focused alert activity vecchio reverend highway foolish
+compliment bastards attend scheme aid worker wheelchair protective poetry
+gentle script reverse picnic knee intended construction cage wednesday
+voices toes stink scares pour effects cheated tower time's slide ruining
+recent jewish filling exit cottage corporate upside supplies proves parked
+instance grounds diary complaining basis wounded thing's politics confessed
+pipe merely massage data chop budget brief spill prayer costs betray begins
+arrangement waiter scam rats fraud flu brush anyone's adopted tables
+sympathy pill pee web seventeen landed expression entrance employee drawing
+cap bracelet principal pays jen's fairly facility dru deeper arrive unique
+tracking spite shed recommend oughta nanny naive menu grades diet corn
+authorities separated roses patch dime devastated description tap subtle
+include citizen bullets beans ric pile las executive confirm toe strings
+parade harbor charity's bow borrowed toys straighten steak status remote
+premonition poem planted honored youth specifically meetings exam
+convenient traveling matches laying insisted apply units technology dish
+aitoro sis kindly grandson donor temper teenager strategy richard's proven
+iron denial couples backwards tent swell noon happiest episode drives
+thinkin spirits potion fence affairs acts whatsoever rehearsal proved
+overheard nuclear lemme hostage faced constant bench tryin taxi shove sets
+moron limits impress entitled needle limit lad intelligent instant forms
+disagree stinks rianna recover paul's losers groom gesture developed
+constantly blocks bartender tunnel suspects sealed removed legally illness
+hears dresses aye vehicle thy teachers sheet receive psychic night's denied
+knocking judging bible behalf accidentally waking ton superior seek rumor
+natalie's manners homeless hollow desperately critical theme tapes
+referring personnel item genoa gear majesty fans exposed cried tons spells
+producer launch instinct belief quote motorcycle convincing appeal advance
+greater fashioned aids accomplished mommy's grip bump upsetting soldiers
+scheduled production needing invisible forgiveness feds complex compare
+bothers tooth territory sacred mon jessica's inviting inner earn compromise
+cocktail tramp temperature signing landing jabot intimate dignity dealt
+souls informed gods entertainment dressing cigarettes blessing billion
+alistair upper manner lightning leak heaven's fond corky alternative seduce
+players operate modern liquor fingerprints enchantment butters stuffed
+stavros rome filed emotionally division conditions uhm transplant tips
+passes oxygen nicely lunatic hid drill designs complain announcement
+visitors unfortunate slap prayers plug organization opens oath o'neill
+mutual graduate confirmed broad yacht spa remembers fried extraordinary
+bait appearance abuse warton sworn stare safely reunion plot burst aha
+might've experiment dive commission cells aboard returning independent
+expose environment buddies trusting smaller mountains booze sweep sore
+scudder properly parole manhattan effective ditch decides canceled bra
+antonio's speaks spanish reaching glow foundation women's wears thirsty
+skull ringing dorm dining bend unexpected systems sob pancakes michael's
+harsh flattered existence ahhh troubles proposed fights favourite eats
+driven computers rage luke's causes border undercover spoiled sloane shine
+rug identify destroying deputy deliberately conspiracy clothing thoughtful
+similar sandwiches plates nails miracles investment fridge drank contrary
+beloved allergic washed stalking solved sack misses hope's forgiven erica's
+cuz bent approval practical organized maciver involve industry fuel
+dragging cooked possession pointing foul editor dull beneath ages horror
+heels grass faking deaf stunt portrait painted jealousy hopeless fears cuts
+conclusion volunteer scenario satellite necklace men's crashed chapel
+accuse restraining jason's humans homicide helicopter formal firing shortly
+safer devoted auction videotape tore stores reservations pops appetite
+anybody's wounds vanquish symbol prevent patrol ironic flow fathers
+excitement anyhow tearing sends sam's rape laughed function core charmed
+whatever's sub lucy's dealer cooperate bachelor accomplish wakes struggle
+spotted sorts reservation ashes yards votes tastes supposedly loft
+intentions integrity wished towels suspected slightly qualified log
+investigating inappropriate immediate companies backed pan owned lipstick
+lawn compassion cafeteria belonged affected scarf precisely obsession
+management loses lighten jake's infection granddaughter explode chemistry
+balcony this'll storage spying publicity exists employees depend cue
+cracked conscious aww ally ace accounts absurd vicious tools strongly rap
+invented forbid directions defendant bare announce alcazar's screwing
+salesman robbed leap lakeview insanity injury genetic document why's reveal
+religious possibilities kidnap gown entering chairs wishing statue setup
+serial punished dramatic dismissed criminals seventh regrets raped quarters
+produce lamp dentist anyways anonymous added semester risks regarding owes
+magazines machines lungs explaining delicate child's tricked oldest liv
+eager doomed cafe bureau adoption traditional surrender stab sickness scum
+loop independence generation floating envelope entered combination chamber
+worn vault sorel pretended potatoes plea photograph payback misunderstood
+kiddo healing cascade capeside application stabbed remarkable cabinet brat
+wrestling sixth scale privilege passionate nerves lawsuit kidney disturbed
+crossing cozy associate tire shirts required posted oven ordering mill
+journal gallery delay clubs risky nest monsters honorable grounded favour
+culture closest brenda's breakdown attempted tony's placed conflict bald
+actress abandon steam scar pole duh collar worthless standards resources
+photographs introduced injured graduation enormous disturbing disturb
+distract deals conclusions vodka situations require mid measure dishes
+crawling congress children's briefcase wiped whistle sits roast rented pigs
+greek flirting existed deposit damaged bottles vanessa's types topic riot
+overreacting minimum logical impact hostile embarrass casual beacon amusing
+altar values recognized maintain goods covers claus battery survival skirt
+shave prisoners porch med ghosts favors drops dizzy chili begun beaten
+advise transferred strikes rehab raw photographer peaceful leery heavens
+fortunately fooling expectations draft citizens weakness ski ships ranch
+practicing musical movement individual homes executed examine documents
+cranes column bribe task species sail rum resort prescription operating
+hush fragile forensics expense drugged differences cows conduct comic bells
+avenue attacking assigned visitor suitcase sources sorta scan payment motor
+mini manticore inspired insecure imagining hardest clerk yea wrist what'll
+tube starters silk pump pale nicer haul flies demands boot arts african
+there'd limited how're elders connections quietly pulls idiots factor erase
+denying attacks ankle amnesia accepting ooo heartbeat gal devane confront
+backing phrase operations minus meets legitimate hurricane fixing
+communication boats auto arrogant supper studies slightest sins sayin
+recipe pier paternity humiliating genuine catholic snack rational pointed
+minded guessed grace's display dip brooke's advanced weddings unh tumor
+teams reported humiliated destruction copies closely bid aspirin academy
+wig throughout spray occur logic eyed equal drowning contacts shakespeare
+ritual perfume kelly's hiring hating generally error elected docks
+creatures visions thanking thankful sock replaced nineteen nick's fork
+comedy analysis yale throws teenagers studied stressed slice rolls requires
+plead ladder kicks detectives assured alison's widow tomorrow's tissue
+tellin shallow responsibilities repay rejected permanently girlfriends
+deadly comforting ceiling bonus verdict maintenance jar insensitive factory
+aim triple spilled respected recovered messy interrupted halliwell car's
+bleed benefits wardrobe takin significant objective murders doo chart backs
+workers waves underestimate ties registered multiple justify harmless
+frustrated fold enzo convention communicate bugging attraction arson whack
+salary rumors residence party's obligation medium liking laura's
+development develop dearest david's danny's congratulate vengeance
+switzerland severe rack puzzle puerto guidance fires courtesy caller blamed
+tops repair quiz prep now's involves headquarters curiosity codes circles
+barbecue troops sunnydale spinning scores pursue psychotic cough claimed
+accusations shares resent money's laughs gathered freshman envy drown
+cristian's bartlet asses sofa scientist poster islands highness dock
+apologies welfare victor's theirs stat stall spots somewhat ryan's realizes
+psych fools finishing album wee understandable unable treats theatre
+succeed stir relaxed makin inches gratitude faithful bin accent zip witter
+wandering regardless que locate inevitable gretel deed crushed controlling
+taxes smelled settlement robe poet opposed marked greenlee's gossip
+gambling determine cuba cosmetics cent accidents surprising stiff sincere
+shield rushed resume reporting refrigerator reference preparing nightmares
+mijo ignoring hunch fog fireworks drowned crown cooperation brass accurate
+whispering sophisticated religion luggage investigate hike explore emotion
+creek crashing contacted complications ceo acid shining rolled righteous
+reconsider inspiration goody geek frightening festival ethics creeps
+courthouse camping assistance affection vow smythe protest lodge haircut
+forcing essay chairman baked apologized vibe respects receipt mami includes
+hats exclusive destructive define defeat adore adopt voted tracked signals
+shorts rory's reminding relative ninth floors dough creations continues
+cancelled cabot barrel adam's snuck slight reporters rear pressing novel
+newspapers magnificent madame lazy glorious fiancee candidate brick bits
+australia activities visitation scholarship sane previous kindness ivy's
+shoulda rescued mattress maria's lounge lifted label importantly glove
+enterprises driver's disappointment condo cemetery beings admitting yelled
+waving screech satisfaction requested reads plants nun nailed described
+dedicated certificate centuries annual worm tick resting primary polish
+marvelous fuss funds defensive cortlandt compete chased provided pockets
+luckily lilith filing depression conversations consideration consciousness
+worlds innocence indicate grandmother's forehead bam appeared aggressive
+trailer slam retirement quitting pry person's narrow levels kay's inform
+encourage dug delighted daylight danced currently confidential billy's
+ben's aunts washing vic tossed spectra rick's permit marrow lined implying
+hatred grill efforts corpse clues sober relatives promotion offended morgue
+larger infected humanity eww emily's electricity electrical distraction
+cart broadcast wired violation suspended promising harassment glue
+gathering d'angelo cursed controlled calendar brutal assets warlocks wagon
+unpleasant proving priorities observation mustn't lease grows flame
+domestic disappearance depressing thrill sitter ribs offers naw flush
+exception earrings deadline corporal collapsed update snapped smack orleans
+offices melt figuring delusional coulda burnt actors trips tender sperm
+specialist scientific realise pork popped planes kev interrogation
+institution included esteem communications choosing choir undo pres prayed
+plague manipulate lifestyle insulting honour detention delightful
+coffeehouse chess betrayal apologizing adjust wrecked wont whipped rides
+reminder psychological principle monsieur injuries fame faint confusion
+christ's bon bake nearest korea industries execution distress definition
+creating correctly complaint blocked trophy tortured structure rot risking
+pointless household heir handing eighth dumping cups chloe's alibi absence
+vital tokyo thus struggling shiny risked refer mummy mint joey's
+involvement hose hobby fortunate fleischman fitting curtain counseling
+addition wit transport technical rode puppet opportunities modeling memo
+irresponsible humiliation hiya freakin fez felony choke blackmailing
+appreciated tabloid suspicion recovering rally psychology pledge panicked
+nursery louder jeans investigator identified homecoming helena's height
+graduated frustrating fabric distant buys busting buff wax sleeve products
+philosophy irony hospitals dope declare autopsy workin torch substitute
+scandal prick limb leaf lady's hysterical growth goddamnit fetch dimension
+day's crowded clip climbing bonding approved yeh woah ultimately trusts
+returns negotiate millennium majority lethal length iced deeds bore
+babysitter questioned outrageous medal kiriakis insulted grudge established
+driveway deserted definite capture beep wires suggestions searched owed
+originally nickname lighting lend drunken demanding costanza conviction
+characters bumped weigh touches tempted shout resolve relate poisoned pip
+phoebe's pete's occasionally molly's meals maker invitations haunted fur
+footage depending bogus autograph affects tolerate stepping spontaneous
+sleeps probation presentation performed manny identical fist cycle
+associates aaron's streak spectacular sector lasted isaac's increase
+hostages heroin havin habits encouraging cult consult burgers boyfriends
+bailed baggage association wealthy watches versus troubled torturing
+teasing sweetest stations sip shawn's rag qualities postpone pad
+overwhelmed malkovich impulse hut follows classy charging barbara's angel's
+amazed scenes rising revealed representing policeman offensive mug
+hypocrite humiliate hideous finals experiences d'ya courts costumes
+captured bluffing betting bein bedtime alcoholic vegetable tray suspicions
+spreading splendid shouting roots pressed nooo liza's jew intent grieving
+gladly fling eliminate disorder courtney's cereal arrives aaah yum
+technique statements sonofabitch servant roads republican paralyzed orb
+lotta locks guaranteed european dummy discipline despise dental corporation
+carries briefing bluff batteries atmosphere whatta tux sounding servants
+rifle presume kevin's handwriting goals gin fainted elements dried cape
+allright allowing acknowledge whacked toxic skating reliable quicker
+penalty panel overwhelming nearby lining importance harassing fatal endless
+elsewhere dolls convict bold ballet whatcha unlikely spiritual shutting
+separation recording positively overcome goddam failing essence dose
+diagnosis cured claiming bully airline ahold yearbook various tempting
+shelf rig pursuit prosecution pouring possessed partnership miguel's
+lindsay's countries wonders tsk thorough spine rath psychiatric meaningless
+latte jammed ignored fiance exposure exhibit evidently duties contempt
+compromised capacity cans weekends urge theft suing shipment scissors
+responding refuses proposition noises matching located ink hormones hiv
+hail grandchildren godfather gently establish crane's contracts compound
+buffy's worldwide smashed sexually sentimental senor scored patient's
+nicest marketing manipulated jaw intern handcuffs framed errands
+entertaining discovery crib carriage barge awards attending ambassador
+videos tab spends slipping seated rubbing rely reject recommendation reckon
+ratings headaches float embrace corners whining sweating sole skipped
+restore receiving population pep mountie motives mama's listens korean
+heroes heart's cristobel controls cheerleader balsom unnecessary stunning
+shipping scent santa's quartermaines praise pose montega luxury loosen
+kyle's keri's info hum haunt gracious git forgiving fleet errand emperor
+cakes blames abortion worship theories strict sketch shifts plotting
+physician perimeter passage pals mere mattered lonigan longest jews
+interference eyewitness enthusiasm encounter diapers craig's artists
+strongest shaken serves punched projects portal outer nazi hal's colleagues
+catches bearing backyard academic winds terrorists sabotage pea organs
+needy mentor measures listed lex cuff civilization caribbean articles
+writes woof who'll viki's valid rarely rabbi prank performing obnoxious
+mates improve hereby gabby faked cellar whitelighter void substance
+strangle sour skill senate purchase native muffins interfering hoh gina's
+demonic colored clearing civilian buildings boutique barrington trading
+terrace smoked seed righty relations quack published preliminary petey pact
+outstanding opinions knot ketchup items examined disappearing cordy coin
+circuit assist administration walt uptight ticking terrifying tease
+tabitha's syd swamp secretly rejection reflection realizing rays
+pennsylvania partly mentally marone jurisdiction frasier's doubted
+deception crucial congressman cheesy arrival visited supporting stalling
+scouts scoop ribbon reserve raid notion income immune grandma's expects
+edition destined constitution classroom bets appreciation appointed
+accomplice whitney's wander shoved sewer scroll retire paintings lasts
+fugitive freezer discount cranky crank clearance bodyguard anxiety
+accountant abby's whoops volunteered terrorist tales talents stinking
+resolved remotely protocol livvie's garlic decency cord beds asa's areas
+altogether uniforms tremendous restaurants rank profession popping
+philadelphia outa observe lung largest hangs feelin experts enforcement
+encouraged economy dudes donation disguise diane's curb continued
+competitive businessman bites antique advertising ads toothbrush retreat
+represents realistic profits predict nora's lid landlord hourglass hesitate
+frank's focusing equally consolation boyfriend's babbling aged troy's
+tipped stranded smartest sabrina's rhythm replacement repeating puke psst
+paycheck overreacted macho leadership kendall's juvenile john's images
+grocery freshen disposal cuffs consent caffeine arguments agrees abigail's
+vanished unfinished tobacco tin syndrome ripping pinch missiles isolated
+flattering expenses dinners cos colleague ciao buh belthazor belle's
+attorneys amber's woulda whereabouts wars waitin visits truce tripped tee
+tasted stu steer ruling poisoning nursing manipulative immature husbands
+heel granddad delivering deaths condoms automatically anchor trashed
+tournament throne raining prices pasta needles leaning leaders judges ideal
+detector coolest casting batch approximately appointments almighty achieve
+vegetables sum spark ruled revolution principles perfection pains momma
+mole interviews initiative hairs getaway employment den cracking counted
+compliments behold verge tougher timer tapped taped stakes specialty
+snooping shoots semi rendezvous pentagon passenger leverage jeopardize
+janitor grandparents forbidden examination communist clueless cities
+bidding arriving adding ungrateful unacceptable tutor soviet shaped serum
+scuse savings pub pajamas mouths modest methods lure irrational depth cries
+classified bombs beautifully arresting approaching vessel variety traitor
+sympathetic smug smash rental prostitute premonitions mild jumps inventory
+ing improved grandfather's developing darlin committing caleb's banging
+asap amendment worms violated vent traumatic traced tow swiss sweaty shaft
+recommended overboard literature insight healed grasp fluid experiencing
+crappy crab connecticut chunk chandler's awww applied witnessed traveled
+stain shack reacted pronounce presented poured occupied moms marriages
+jabez invested handful gob gag flipped fireplace expertise embarrassment
+disappears concussion bruises brakes anything's week's twisting tide swept
+summon splitting settling scientists reschedule regard purposes ohio notch
+mike's improvement hooray grabbing extend exquisite disrespect complaints
+colin's armor voting thornhart sustained straw slapped simon's shipped
+shattered ruthless reva's refill recorded payroll numb mourning marijuana
+manly jerry's involving hunk entertain earthquake drift dreadful doorstep
+confirmation chops bridget's appreciates announced vague tires stressful
+stem stashed stash sensed preoccupied predictable noticing madly halls
+gunshot embassy dozens dinner's confuse cleaners charade chalk cappuccino
+breed bouquet amulet addiction who've warming unlock transition satisfy
+sacrificed relaxing lone input hampshire girlfriend's elaborate concerning
+completed channels category cal blocking blend blankets america's addicted
+yuck voters professionals positions monica's mode initial hunger hamburger
+greeting greet gravy gram dreamt dice declared collecting caution brady's
+backpack agreeing writers whale tribe taller supervisor sacrifices
+radiation poo phew outcome ounce missile meter likewise irrelevant gran
+felon feature favorites farther fade experiments erased easiest disk
+convenience conceived compassionate challenged cane blair's backstage agony
+adores veins tweek thieves surgical strangely stetson recital proposing
+productive meaningful marching immunity hassle goddamned frighten directors
+dearly comments closure cease ambition wisconsin unstable sweetness salvage
+richer refusing raging pumping pressuring petition mortals lowlife jus
+intimidated intentionally inspire forgave eric's devotion despicable
+deciding dash comfy breach bo's bark alternate aaaah switching swallowed
+stove slot screamed scars russians relevant poof pipes persons pawn losses
+legit invest generations farewell experimental difficulty curtains
+civilized championship caviar boost token tends temporarily superstition
+supernatural sunk sadness reduced recorder psyched presidential owners
+motivated microwave lands karen's hallelujah gap fraternity engines dryer
+cocoa chewing additional acceptable unbelievably survivor smiled smelling
+sized simpler sentenced respectable remarks registration premises
+passengers organ occasional khasinau indication gutter grabs goo fulfill
+flashlight ellenor courses blooded blessings beware beth's bands advised
+water's uhhh turf swings slips shocking resistance privately olivia's
+mirrors lyrics locking instrument historical heartless fras decades
+comparison childish cassie's cardiac admission utterly tuscany ticked
+suspension stunned statesville sadly resolution reserved purely opponent
+noted lowest kiddin jerks hitch flirt fare extension establishment equals
+dismiss delayed decade christening casket c'mere breakup brad's biting
+antibiotics accusation abducted witchcraft whoever's traded thread spelling
+so's school's runnin remaining punching protein printed paramedics newest
+murdering mine's masks lawndale intact ins initials heights grampa
+democracy deceased colleen's choking charms careless bushes buns bummed
+accounting travels taylor's shred saves saddle rethink regards references
+precinct persuade patterns meds manipulating llanfair leash kenny's housing
+hearted guarantees flown feast extent educated disgrace determination
+deposition coverage corridor burial bookstore boil abilities vitals veil
+trespassing teaches sidewalk sensible punishing overtime optimistic
+occasions obsessing oak notify mornin jeopardy jaffa injection hilarious
+distinct directed desires curve confide challenging cautious alter yada
+wilderness where're vindictive vial tomb teeny subjects stroll sittin scrub
+rebuild rachel's posters parallel ordeal orbit o'brien nuns max's
+jennifer's intimacy inheritance fails exploded donate distracting despair
+democratic defended crackers commercials bryant's ammunition wildwind
+virtue thoroughly tails spicy sketches sights sheer shaving seize scarecrow
+refreshing prosecute possess platter phillip's napkin misplaced merchandise
+membership loony jinx heroic frankenstein fag efficient devil's corps clan
+boundaries attract ambitious virtually syrup solitary resignation
+resemblance reacting pursuing premature pod liz's lavery journalist honors
+harvey's genes flashes erm contribution company's client's cheque charts
+cargo awright acquainted wrapping untie salute ruins resign realised
+priceless partying myth moonlight lightly lifting kasnoff insisting glowing
+generator flowing explosives employer cutie confronted clause buts
+breakthrough blouse ballistic antidote analyze allowance adjourned vet unto
+understatement tucked touchy toll subconscious sequence screws sarge
+roommates reaches rambaldi programs offend nerd knives kin irresistible
+inherited incapable hostility goddammit fuse frat equation curfew centered
+blackmailed allows alleged walkin transmission text starve sleigh sarcastic
+recess rebound procedures pinned parlor outfits livin issued institute
+industrial heartache head's haired fundraiser doorman documentary discreet
+dilucca detect cracks cracker considerate climbed catering author apophis
+zoey vacuum urine tunnels todd's tanks strung stitches sordid sark referred
+protector portion phoned pets paths mat lengths kindergarten hostess flaw
+flavor discharge deveraux consumed confidentiality automatic amongst viktor
+victim's tactics straightened specials spaghetti soil prettier powerless
+por poems playin playground parker's paranoia nsa mainly mac's joe's
+instantly havoc exaggerating evaluation eavesdropping doughnuts diversion
+deepest cutest companion comb bela behaving avoided anyplace agh accessory
+zap whereas translate stuffing speeding slime polls personalities payments
+musician marital lurking lottery journalism interior imaginary hog guinea
+greetings game's fairwinds ethical equipped environmental elegant elbow
+customs cuban credibility credentials consistent collapse cloth claws
+chopped challenges bridal boards bedside babysitting authorized assumption
+ant youngest witty vast unforgivable underworld tempt tabs succeeded
+sophomore selfless secrecy runway restless programming professionally okey
+movin metaphor messes meltdown lecter incoming hence gasoline gained
+funding episodes diefenbaker contain comedian collected cam buckle assembly
+ancestors admired adjustment acceptance weekly warmth throats seduced
+ridge's reform rebecca's queer poll parenting noses luckiest graveyard
+gifted footsteps dimeras cynical assassination wedded voyage volunteers
+verbal unpredictable tuned stoop slides sinking show's rio rigged
+regulations region promoted plumbing lingerie layer katie's hankey greed
+everwood essential elope dresser departure dat dances coup chauffeur
+bulletin bugged bouncing website tubes temptation supported strangest
+sorel's slammed selection sarcasm rib primitive platform pending partial
+packages orderly obsessive nevertheless nbc murderers motto meteor
+inconvenience glimpse froze fiber execute etc ensure drivers dispute
+damages crop courageous consulate closes bosses bees amends wuss wolfram
+wacky unemployed traces town's testifying tendency syringe symphony stew
+startled sorrow sleazy shaky screams rsquo remark poke phone's philip's
+nutty nobel mentioning mend mayor's iowa inspiring impulsive housekeeper
+germans formed foam fingernails economic divide conditioning baking whine
+thug starved sedative rose's reversed publishing programmed picket paged
+nowadays newman's mines margo's invasion homosexual homo hips forgets
+flipping flea flatter dwell dumpster consultant choo banking assignments
+apartments ants affecting advisor vile unreasonable tossing thanked steals
+souvenir screening scratched rep psychopath proportion outs operative
+obstruction obey neutral lump lily's insists ian's harass gloat flights
+filth extended electronic edgy diseases didn coroner confessing cologne
+cedar bruise betraying bailing attempting appealing adebisi wrath wandered
+waist vain traps transportation stepfather publicly presidents poking
+obligated marshal lexie's instructed heavenly halt employed diplomatic
+dilemma crazed contagious coaster cheering carved bundle approached
+appearances vomit thingy stadium speeches robbing reflect raft qualify
+pumped pillows peep pageant packs neo neglected m'kay loneliness liberal
+intrude indicates helluva gardener freely forresters err drooling
+continuing betcha alan's addressed acquired vase supermarket squat spitting
+spaces slaves rhyme relieve receipts racket purchased preserve pictured
+pause overdue officials nod motivation morgendorffer lucky's lacking
+kidnapper introduction insect hunters horns feminine eyeballs dumps disc
+disappointing difficulties crock convertible context claw clamp canned
+cambias bathtub avanya artery weep warmer vendetta tenth suspense summoned
+stuff's spiders sings reiber raving pushy produced poverty postponed ohhhh
+noooo mold mice laughter incompetent hugging groceries frequency fastest
+drip differ daphne's communicating body's beliefs bats bases auntie adios
+wraps willingly weirdest voila timmih thinner swelling swat steroids
+sensitivity scrape rehearse quarterback organic matched ledge justified
+insults increased heavily hateful handles feared doorway decorations colour
+chatting buyer buckaroo bedrooms batting askin ammo tutoring subpoena span
+scratching requests privileges pager mart kel intriguing idiotic hotels
+grape enlighten dum door's dixie's demonstrate dairy corrupt combined
+brunch bridesmaid barking architect applause alongside ale acquaintance yuh
+wretched superficial sufficient sued soak smoothly sensing restraint quo
+pow posing pleading pittsburgh peru payoff participate organize oprah nemo
+morals loans loaf lists laboratory jumpy intervention ignorant herbal
+hangin germs generosity flashing country's convent clumsy chocolates
+captive bianca's behaved apologise vanity trials stumbled republicans
+represented recognition preview poisonous perjury parental onboard mugged
+minding linen learns knots interviewing inmates ingredients humour grind
+greasy goons estimate elementary edmund's drastic database coop comparing
+cocky clearer bruised brag bind axe asset apparent ann's worthwhile whoop
+wedding's vanquishing tabloids survivors stenbeck's sprung spotlight shops
+sentencing sentences revealing reduce ram racist provoke piper's pining
+overly oui ops mop louisiana locket king's jab imply impatient hovering
+hotter fest endure dots doren dim diagnosed debts cultures crawled
+contained condemned chained brit breaths adds weirdo warmed wand utah
+troubling tok'ra stripped strapped soaked skipping sharon's scrambled
+rattle profound musta mocking mnh misunderstand merit loading linked
+limousine kacl investors interviewed hustle forensic foods enthusiastic
+duct drawers devastating democrats conquer concentration comeback clarify
+chores cheerleaders cheaper charlie's callin blushing barging abused yoga
+wrecking wits waffles virginity vibes uninvited unfaithful underwater
+tribute strangled state's scheming ropes responded residents rescuing rave
+priests postcard overseas orientation ongoing o'reily newly neil's morphine
+lotion limitations lesser lectures lads kidneys judgement jog itch
+intellectual installed infant indefinitely grenade glamorous genetically
+freud faculty engineering doh discretion delusions declaration crate
+competent commonwealth catalog bakery attempts asylum argh applying ahhhh
+yesterday's wedge wager unfit tripping treatments torment superhero
+stirring spinal sorority seminar scenery repairs rabble pneumonia perks owl
+override ooooh moo mija manslaughter mailed love's lime lettuce intimidate
+instructor guarded grieve grad globe frustration extensive exploring
+exercises eve's doorbell devices deal's dam cultural ctu credits commerce
+chinatown chemicals baltimore authentic arraignment annulled altered
+allergies wanta verify vegetarian tunes tourist tighter telegram suitable
+stalk specimen spared solving shoo satisfying saddam requesting publisher
+pens overprotective obstacles notified negro nasedo judged jill's
+identification grandchild genuinely founded flushed fluids floss escaping
+ditched demon's decorated criticism cramp corny contribute connecting bunk
+bombing bitten billions bankrupt yikes wrists ultrasound ultimatum thirst
+spelled sniff scope ross's room's retrieve releasing reassuring pumps
+properties predicted neurotic negotiating needn't multi monitors
+millionaire microphone mechanical lydecker limp incriminating hatchet
+gracias gordie fills feeds egypt doubting dedication decaf dawson's
+competing cellular biopsy whiz voluntarily visible ventilator unpack unload
+universal tomatoes targets suggests strawberry spooked snitch schillinger
+sap reassure providing prey pressure's persuasive mystical mysteries mri
+moment's mixing matrimony mary's mails lighthouse liability kgb jock
+headline frankie's factors explosive explanations dispatch detailed curly
+cupid condolences comrade cassadines bulb brittany's bragging awaits
+assaulted ambush adolescent adjusted abort yank whit verse vaguely
+undermine tying trim swamped stitch stan's stabbing slippers skye's
+sincerely sigh setback secondly rotting rev retail proceedings preparation
+precaution pox pcpd nonetheless melting materials mar liaison hots hooking
+headlines hag ganz fury felicity fangs expelled encouragement earring
+dreidel draws dory donut dog's dis dictate dependent decorating coordinates
+cocktails bumps blueberry believable backfired backfire apron anticipated
+adjusting activated vous vouch vitamins vista urn uncertain ummm tourists
+tattoos surrounding sponsor slimy singles sibling shhhh restored
+representative renting reign publish planets peculiar parasite paddington
+noo marries mailbox magically lovebirds listeners knocks kane's informant
+grain exits elf drazen distractions disconnected dinosaurs designing
+dashwood crooked conveniently contents argued wink warped underestimated
+testified tacky substantial steve's steering staged stability shoving
+seizure reset repeatedly radius pushes pitching pairs opener mornings
+mississippi matthew's mash investigations invent indulge horribly
+hallucinating festive eyebrows expand enjoys dictionary dialogue
+desperation dealers darkest daph critic consulting cartman's canal boragora
+belts bagel authorization auditions associated ape amy's agitated
+adventures withdraw wishful wimp vehicles vanish unbearable tonic tom's
+tackle suffice suction slaying singapore safest rosanna's rocking relive
+rates puttin prettiest oval noisy newlyweds nauseous moi misguided mildly
+midst maps liable kristina's judgmental introducing individuals hunted hen
+givin frequent fisherman fascinated elephants dislike diploma deluded
+decorate crummy contractions carve careers bottled bonded bahamas
+unavailable twenties trustworthy translation traditions surviving surgeons
+stupidity skies secured salvation remorse rafe's princeton preferably pies
+photography operational nuh northwest nausea napkins mule mourn melted
+mechanism mashed julia's inherit holdings hel greatness golly excused edges
+dumbo drifting delirious damaging cubicle compelled comm colleges cole's
+chooses checkup chad's certified candidates boredom bob's bandages
+baldwin's bah automobile athletic alarms absorbed absent windshield who're
+whaddya vitamin transparent surprisingly sunglasses starring slit sided
+schemes roar relatively reade quarry prosecutor prognosis probe potentially
+pitiful persistent perception percentage peas oww nosy neighbourhood
+nagging morons molecular meters masterpiece martinis limbo liars jax's
+irritating inclined hump hoynes haw gauge functions fiasco educational
+eatin donated destination dense cubans continent concentrating commanding
+colorful clam cider brochure behaviour barto bargaining awe artistic
+welcoming weighing villain vein vanquished striking stains sooo smear sire
+simone's secondary roughly rituals resentment psychologist preferred pint
+pension passive overhear origin orchestra negotiations mounted morality
+landingham labs kisser jackson's icy hoot holling handshake grilled
+functioning formality elevators edward's depths confirms civilians bypass
+briefly boathouse binding acres accidental westbridge wacko ulterior
+transferring tis thugs tangled stirred stefano's sought snag smallest sling
+sleaze seeds rumour ripe remarried reluctant regularly puddle promote
+precise popularity pins perceptive miraculous memorable maternal lucinda's
+longing lockup locals librarian job's inspection impressions immoral
+hypothetically guarding gourmet gabe fighters fees features faxed extortion
+expressed essentially downright digest der crosses cranberry city's chorus
+casualties bygones buzzing burying bikes attended allah all's weary viewing
+viewers transmitter taping takeout sweeping stepmother stating stale
+seating seaborn resigned rating prue's pros pepperoni ownership occurs
+nicole's newborn merger mandatory malcolm's ludicrous jan's injected
+holden's henry's heating geeks forged faults expressing eddie's drue dire
+dief desi deceiving centre celebrities caterer calmed businesses budge
+ashley's applications ankles vending typing tribbiani there're squared
+speculation snowing shades sexist scudder's scattered sanctuary rewrite
+regretted regain raises processing picky orphan mural misjudged miscarriage
+memorize marshall's mark's licensed lens leaking launched larry's languages
+judge's jitters invade interruption implied illegally handicapped glitch
+gittes finer fewer engineered distraught dispose dishonest digs dahlia's
+dads cruelty conducting clinical circling champions canceling butterflies
+belongings barbrady amusement allegations alias aging zombies where've
+unborn tri swearing stables squeezed spaulding's slavery sew sensational
+revolutionary resisting removing radioactive races questionable privileged
+portofino par owning overlook overhead orson oddly nazis musicians
+interrogate instruments imperative impeccable icu hurtful hors heap
+harley's graduating graders glance endangered disgust devious destruct
+demonstration creates crazier countdown coffee's chump cheeseburger cat's
+burglar brotherhood berries ballroom assumptions ark annoyed allies allergy
+advantages admirer admirable addresses activate accompany wed victoria's
+valve underpants twit triggered teacher's tack strokes stool starr's sham
+seasons sculpture scrap sailed retarded resourceful remarkably refresh
+ranks pressured precautions pointy obligations nightclub mustache month's
+minority mind's maui lace isabella's improving iii hunh hubby flare fierce
+farmers dont dokey divided demise demanded dangerously crushing
+considerable complained clinging choked chem cheerleading checkbook
+cashmere calmly blush believer aspect amazingly alas acute a's yak whores
+what've tuition trey's tolerance toilets tactical tacos stairwell spur
+spirited slower sewing separately rubbed restricted punches protects
+partially ole nuisance niagara motherfuckers mingle mia's kynaston knack
+kinkle impose hosting harry's gullible grid godmother funniest friggin
+folding financially filming fashions eater dysfunctional drool
+distinguished defence defeated cruising crude criticize corruption
+contractor conceive clone circulation cedars caliber brighter blinded
+birthdays bio bill's banquet artificial anticipate annoy achievement whim
+whichever volatile veto vested uncle's supports successfully shroud
+severely rests representation quarantine premiere pleases parent's painless
+pads orphans orphanage offence obliged nip niggers negotiation narcotics
+nag mistletoe meddling manifest lookit loo lilah investigated intrigued
+injustice homicidal hayward's gigantic exposing elves disturbance
+disastrous depended demented correction cooped colby's cheerful buyers
+brownies beverage basics attorney's atm arvin arcade weighs upsets
+unethical tidy swollen sweaters swap stupidest sensation scalpel rail
+prototype props prescribed pompous poetic ploy paws operates objections
+mushrooms mulwray monitoring manipulation lured lays lasting kung keg jell
+internship insignificant inmate incentive gandhi fulfilled flooded
+expedition evolution discharged disagreement dine dean's crypt coroner's
+cornered copied confrontation cds catalogue brightest beethoven banned
+attendant athlete amaze airlines yogurt wyndemere wool vocabulary vcr tulsa
+tags tactic stuffy slug sexuality seniors segment revelation respirator
+pulp prop producing processed pretends polygraph perp pennies ordinarily
+opposition olives necks morally martyr martial lisa's leftovers joints
+jimmy's irs invaded imported hopping homey hints helicopters heed heated
+heartbroken gulf greatly forge florist firsthand fiend expanding emma's
+defenses crippled cousin's corrected conniving conditioner clears chemo
+bubbly bladder beeper baptism apb answer's anna's angles ache womb wiring
+wench weaknesses volunteering violating unlocked unemployment tummy
+`
+
+// namesList contains common first names.
+var namesList = `
+mary james patricia john linda robert barbara michael elizabeth william
+jennifer david maria richard susan charles margaret joseph dorothy thomas
+lisa christopher nancy daniel karen paul betty mark helen donald sandra
+george donna kenneth carol steven ruth edward sharon brian michelle ronald
+laura anthony sarah kevin kimberly jason deborah matthew jessica gary
+shirley timothy cynthia jose angela larry melissa jeffrey brenda frank amy
+scott anna eric rebecca stephen virginia andrew kathleen raymond pamela
+gregory martha joshua debra jerry amanda dennis stephanie walter carolyn
+patrick christine peter marie harold janet douglas catherine henry frances
+carl ann arthur joyce ryan diane roger alice joe julie juan heather jack
+teresa albert doris jonathan gloria justin evelyn terry jean gerald cheryl
+keith mildred samuel katherine willie joan ralph ashley lawrence judith
+nicholas rose roy janice benjamin kelly bruce nicole brandon judy adam
+christina harry kathy fred theresa wayne beverly billy denise steve tammy
+louis irene jeremy jane aaron lori randy rachel eugene marilyn carlos
+andrea russell kathryn bobby louise victor sara ernest anne phillip
+jacqueline todd wanda jesse bonnie craig julia alan ruby shawn lois
+clarence tina sean phyllis philip norma chris paula johnny diana earl annie
+jimmy lillian antonio emily danny robin bryan peggy tony crystal luis
+gladys mike rita stanley dawn leonard connie nathan florence dale tracy
+manuel edna rodney tiffany curtis carmen norman rosa marvin cindy vincent
+grace glenn wendy jeffery victoria travis edith jeff kim chad sherry jacob
+sylvia melvin josephine alfred thelma kyle shannon francis sheila bradley
+ethel jesus ellen herbert elaine frederick marjorie ray carrie joel
+charlotte edwin monica don esther eddie pauline ricky emma troy juanita
+randall anita barry rhonda bernard hazel mario amber leroy eva francisco
+debbie marcus april micheal leslie theodore clara clifford lucille miguel
+jamie oscar joanne jay eleanor jim valerie tom danielle calvin megan alex
+alicia jon suzanne ronnie michele bill gail lloyd bertha tommy darlene leon
+veronica derek jill darrell erin jerome geraldine floyd lauren leo cathy
+alvin joann tim lorraine wesley lynn dean sally greg regina jorge erica
+dustin beatrice pedro dolores derrick bernice dan audrey zachary yvonne
+corey annette herman june maurice marion vernon dana roberto stacy clyde
+ana glen renee hector ida shane vivian ricardo roberta sam holly rick
+brittany lester melanie brent loretta ramon yolanda tyler jeanette gilbert
+laurie gene katie marc kristen reginald vanessa ruben alma brett sue angel
+elsie nathaniel beth rafael jeanne edgar vicki milton carla raul tara ben
+rosemary cecil eileen duane terri andre gertrude elmer lucy brad tonya
+gabriel ella ron stacey roland wilma jared gina adrian kristin karl jessie
+cory natalie claude agnes erik vera darryl charlene neil bessie christian
+delores javier melinda fernando pearl clinton arlene ted maureen mathew
+colleen tyrone allison darren tamara lonnie joy lance georgia cody
+constance julio lillie kurt claudia allan jackie clayton marcia hugh tanya
+max nellie dwayne minnie dwight marlene armando heidi felix glenda jimmie
+lydia everett viola ian courtney ken marian bob stella jaime caroline casey
+dora alfredo jo alberto vickie dave mattie ivan maxine johnnie irma sidney
+mabel byron marsha julian myrtle isaac lena clifton christy willard deanna
+daryl patsy virgil hilda andy gwendolyn salvador jennie kirk nora sergio
+margie seth nina kent cassandra terrance leah rene penny eduardo kay
+terrence priscilla enrique naomi freddie carole stuart olga fredrick billie
+arturo dianne alejandro tracey joey leona nick jenny luther felicia wendell
+sonia jeremiah miriam evan velma julius becky donnie bobbie otis violet
+trevor kristina luke toni homer misty gerard mae doug shelly kenny daisy
+hubert ramona angelo sherri shaun erika lyle katrina matt claire alfonso
+lindsey orlando lindsay rex geneva carlton guadalupe ernesto belinda pablo
+margarita lorenzo sheryl omar cora wilbur faye blake ada horace natasha
+roderick sabrina kerry isabel abraham marguerite rickey hattie ira harriet
+andres molly cesar cecilia johnathan kristi malcolm brandi rudolph blanche
+damon sandy kelvin rosie rudy joanna preston iris alton eunice archie angie
+marco inez wm lynda pete madeline randolph amelia garry alberta geoffrey
+genevieve jonathon monique felipe jodi bennie janie gerardo kayla ed sonya
+dominic jan loren kristine delbert candace colin fannie guillermo maryann
+earnest opal benny alison noel yvette rodolfo melody myron luz edmund susie
+salvatore olivia cedric flora lowell shelley gregg kristy sherman mamie
+devin lula sylvester lola roosevelt verna israel beulah jermaine antoinette
+forrest candice wilbert juana leland jeannette simon pam irving kelli owen
+whitney rufus bridget woodrow karla kristopher celia levi latoya marcos
+patty gustavo shelia lionel gayle marty della gilberto vicky clint lynne
+nicolas sheri laurence marianne ismael kara orville jacquelyn drew erma
+ervin blanca dewey myra al leticia wilfred pat josh krista hugo roxanne
+ignacio angelica caleb robyn tomas adrienne sheldon rosalie erick alexandra
+frankie brooke darrel bethany rogelio sadie terence bernadette alonzo traci
+elias jody bert kendra elbert nichole ramiro rachael conrad mable noah
+ernestine grady muriel phil marcella cornelius elena lamar krystal rolando
+angelina clay nadine percy kari dexter estelle bradford dianna merle
+paulette darin lora amos mona terrell doreen moses rosemarie irvin desiree
+saul antonia roman janis darnell betsy randal christie tommie freda timmy
+meredith darrin lynette brendan teri toby cristina van eula abel leigh
+dominick meghan emilio sophia elijah eloise cary rochelle domingo gretchen
+aubrey cecelia emmett raquel marlon henrietta emanuel alyssa jerald jana
+edmond gwen emil jenna dewayne tricia otto laverne teddy olive reynaldo
+tasha bret silvia jess elvira trent delia humberto kate emmanuel patti
+stephan lorena louie kellie vicente sonja lamont lila garland lana micah
+darla efrain mindy heath essie rodger mandy demetrius lorene ethan elsa
+eldon josefina rocky jeannie pierre miranda eli dixie bryce lucia antoine
+marta robbie faith kendall lela royce johanna sterling shari grover camille
+elton tami cleveland shawna dylan elisa chuck ebony damian melba reuben ora
+stan nettie leonardo tabitha russel ollie erwin winifred benito kristie
+hans marina monte alisha blaine aimee ernie rena curt myrna quentin marla
+agustin tammie jamal latasha devon bonita adolfo patrice tyson ronda
+wilfredo sherrie bart addie jarrod francine vance deloris denis stacie
+damien adriana joaquin cheri harlan abigail desmond celeste elliot jewel
+darwin cara gregorio adele rebekah lucinda dorthy effie trina reba sallie
+aurora lenora etta lottie kerri trisha nikki estella francisca josie tracie
+marissa karin brittney janelle lourdes laurel helene fern elva corinne
+kelsey ina bettie elisabeth aida caitlin ingrid iva eugenia christa goldie
+maude jenifer therese dena lorna janette latonya candy consuelo tamika
+rosetta debora cherie polly dina jewell fay jillian dorothea nell trudy
+esperanza patrica kimberley shanna helena cleo stefanie rosario ola janine
+mollie lupe alisa lou maribel susanne bette susana elise cecile isabelle
+lesley jocelyn paige joni rachelle leola daphne alta ester petra graciela
+imogene jolene keisha lacey glenna gabriela keri ursula lizzie kirsten
+shana adeline mayra jayne jaclyn gracie sondra carmela marisa rosalind
+charity tonia beatriz marisol clarice jeanine sheena angeline frieda lily
+shauna millie claudette cathleen angelia gabrielle autumn katharine jodie
+staci lea christi justine elma luella margret dominique socorro martina
+margo mavis callie bobbi maritza lucile leanne jeannine deana aileen lorie
+ladonna willa manuela gale selma dolly sybil abby ivy dee winnie marcy
+luisa jeri magdalena ofelia meagan audra matilda leila cornelia bianca
+simone bettye randi virgie latisha barbra georgina eliza leann bridgette
+rhoda haley adela nola bernadine flossie ila greta ruthie nelda minerva
+lilly terrie letha hilary estela valarie brianna rosalyn earline catalina
+ava mia clarissa lidia corrine alexandria concepcion tia sharron rae dona
+ericka jami elnora chandra lenore neva marylou melisa tabatha serena avis
+allie sofia jeanie odessa nannie harriett loraine penelope milagros emilia
+benita allyson ashlee tania esmeralda karina eve pearlie zelma malinda
+noreen tameka saundra hillary amie althea rosalinda lilia alana clare
+alejandra elinor lorrie jerri darcy earnestine carmella noemi marcie liza
+annabelle louisa earlene mallory carlene nita selena tanisha katy julianne
+lakisha edwina maricela margery kenya dollie roxie roslyn kathrine nanette
+charmaine lavonne ilene tammi suzette corine kaye chrystal lina deanne
+lilian juliana
+`
+
+// surnamesList contains common surnames.
+var surnamesList = `
+smith johnson williams jones brown davis miller wilson moore taylor
+anderson jackson white harris martin thompson garcia martinez robinson
+clark rodriguez lewis lee walker hall allen young hernandez king wright
+lopez hill green adams baker gonzalez nelson carter mitchell perez roberts
+turner phillips campbell parker evans edwards collins stewart sanchez
+morris rogers reed cook morgan bell murphy bailey rivera cooper richardson
+cox howard ward torres peterson gray ramirez watson brooks sanders price
+bennett wood barnes ross henderson coleman jenkins perry powell long
+patterson hughes flores washington butler simmons foster gonzales bryant
+alexander griffin diaz hayes myers ford hamilton graham sullivan wallace
+woods cole west owens reynolds fisher ellis harrison gibson mcdonald cruz
+marshall ortiz gomez murray freeman wells webb simpson stevens tucker
+porter hicks crawford boyd mason morales kennedy warren dixon ramos reyes
+burns gordon shaw holmes rice robertson hunt daniels palmer mills nichols
+grant ferguson stone hawkins dunn perkins hudson spencer gardner stephens
+payne pierce berry matthews arnold wagner willis watkins olson carroll
+duncan snyder hart cunningham lane andrews ruiz harper fox riley armstrong
+carpenter weaver greene elliott chavez sims peters kelley franklin lawson
+fields gutierrez schmidt carr vasquez castillo wheeler chapman oliver
+montgomery richards williamson johnston banks meyer bishop mccoy howell
+alvarez morrison hansen fernandez garza burton nguyen jacobs reid fuller
+lynch garrett romero welch larson frazier burke hanson mendoza moreno
+bowman medina fowler brewer hoffman carlson silva pearson holland fleming
+jensen vargas byrd davidson hopkins may herrera wade soto walters neal
+caldwell lowe jennings barnett graves jimenez horton shelton barrett obrien
+castro sutton mckinney lucas miles rodriquez chambers holt lambert fletcher
+watts bates hale rhodes pena beck newman haynes mcdaniel mendez bush vaughn
+parks dawson santiago norris hardy steele curry powers schultz barker
+guzman page munoz ball keller chandler weber walsh lyons ramsey wolfe
+schneider mullins benson sharp bowen barber cummings hines baldwin griffith
+valdez hubbard salazar reeves warner stevenson burgess santos tate cross
+garner mann mack moss thornton mcgee farmer delgado aguilar vega glover
+manning cohen harmon rodgers robbins newton blair higgins ingram reese
+cannon strickland townsend potter goodwin walton rowe hampton ortega patton
+swanson goodman maldonado yates becker erickson hodges rios conner adkins
+webster malone hammond flowers cobb moody quinn pope osborne mccarthy
+guerrero estrada sandoval gibbs gross fitzgerald stokes doyle saunders wise
+colon gill alvarado greer padilla waters nunez ballard schwartz mcbride
+houston christensen klein pratt briggs parsons mclaughlin zimmerman french
+buchanan moran copeland pittman brady mccormick holloway brock poole logan
+bass marsh drake wong jefferson park morton abbott sparks norton huff
+massey figueroa carson bowers roberson barton tran lamb harrington boone
+cortez clarke mathis singleton wilkins cain underwood hogan mckenzie
+collier luna phelps mcguire bridges wilkerson nash summers atkins wilcox
+pitts conley marquez burnett cochran chase davenport hood gates ayala
+sawyer vazquez dickerson hodge acosta flynn espinoza nicholson monroe
+morrow whitaker oconnor skinner ware molina kirby huffman gilmore dominguez
+oneal lang combs kramer hancock gallagher gaines shaffer short wiggins
+mathews mcclain fischer wall small melton hensley bond dyer grimes
+contreras wyatt baxter snow mosley shepherd larsen hoover beasley petersen
+whitehead meyers garrison shields horn savage olsen schroeder hartman
+woodard mueller kemp deleon booth patel calhoun wiley eaton cline navarro
+harrell humphrey parrish duran hutchinson hess dorsey bullock robles beard
+dalton avila rich blackwell york johns blankenship trevino salinas campos
+pruitt callahan montoya hardin guerra mcdowell stafford gallegos henson
+wilkinson booker merritt atkinson orr decker hobbs tanner knox pacheco
+stephenson glass rojas serrano marks hickman english sweeney strong mcclure
+conway roth maynard farrell lowery hurst nixon weiss trujillo ellison sloan
+juarez winters mclean boyer villarreal mccall gentry carrillo ayers lara
+sexton pace hull leblanc browning velasquez leach chang sellers herring
+noble foley bartlett mercado landry durham walls barr mckee bauer rivers
+bradshaw pugh velez rush estes dodson morse sheppard weeks camacho bean
+barron livingston middleton spears branch blevins chen kerr mcconnell
+hatfield harding solis frost giles blackburn pennington woodward finley
+mcintosh koch mccullough blanchard rivas brennan mejia kane benton buckley
+valentine maddox russo mcknight buck moon mcmillan crosby berg dotson mays
+roach church chan richmond meadows faulkner oneill knapp kline ochoa
+jacobson gay hendricks horne shepard hebert cardenas mcintyre waller holman
+donaldson cantu morin gillespie fuentes tillman bentley peck key salas
+rollins gamble dickson battle santana cabrera cervantes howe hinton hurley
+spence zamora yang mcneil suarez petty gould mcfarland sampson carver bray
+macdonald stout hester melendez dillon farley hopper galloway potts joyner
+stein aguirre osborn mercer bender franco rowland sykes pickett sears mayo
+dunlap hayden wilder mckay coffey mccarty ewing cooley vaughan bonner
+cotton holder stark ferrell cantrell fulton lott calderon pollard hooper
+burch mullen fry riddle levy odonnell britt daugherty berger dillard alston
+frye riggs chaney odom duffy fitzpatrick valenzuela mayer alford mcpherson
+acevedo barrera cote reilly compton mooney mcgowan craft clemons wynn
+nielsen baird stanton snider rosales bright witt hays holden rutledge
+kinney clements castaneda slater hahn burks delaney pate lancaster sharpe
+whitfield talley macias burris ratliff mccray madden kaufman goff cash
+bolton mcfadden levine byers kirkland kidd workman carney mcleod holcomb
+england finch sosa haney franks sargent nieves downs rasmussen bird hewitt
+foreman valencia oneil delacruz vinson dejesus hyde forbes gilliam guthrie
+wooten huber barlow boyle mcmahon buckner rocha puckett langley knowles
+cooke velazquez whitley vang shea rouse hartley mayfield elder rankin hanna
+cowan lucero arroyo slaughter haas oconnell minor boucher archer boggs
+dougherty andersen newell crowe wang friedman bland swain holley pearce
+childs yarbrough galvan proctor meeks lozano mora rangel bacon villanueva
+schaefer rosado helms boyce goss stinson lake ibarra hutchins covington
+crowley hatcher mackey bunch womack polk dodd childress childers camp villa
+dye springer mahoney dailey belcher lockhart griggs costa brandt walden
+moser tatum mccann akers lutz pryor orozco mcallister lugo davies shoemaker
+rutherford newsome magee chamberlain blanton simms godfrey flanagan crum
+cordova escobar downing sinclair donahue krueger mcginnis gore farris
+webber corbett andrade starr lyon yoder hastings mcgrath spivey krause
+harden crabtree kirkpatrick arrington ritter mcghee bolden maloney gagnon
+dunbar ponce pike mayes beatty mobley kimball butts montes eldridge braun
+hamm gibbons moyer manley herron plummer elmore cramer rucker pierson
+fontenot field rubio goldstein elkins wills novak hickey worley gorman katz
+dickinson broussard woodruff crow britton nance lehman bingham zuniga
+whaley shafer coffman steward delarosa nix neely mata davila mccabe kessler
+hinkle welsh pagan goldberg goins crouch cuevas quinones mcdermott
+hendrickson samuels denton bergeron lam ivey locke haines snell hoskins
+byrne arias roe corbin beltran chappell downey dooley tuttle couch payton
+mcelroy crockett groves cartwright dickey mcgill dubois muniz tolbert
+dempsey cisneros sewell latham vigil tapia rainey norwood stroud meade
+tipton kuhn hilliard bonilla teague gunn greenwood correa reece poe pineda
+phipps frey kaiser ames gunter schmitt milligan espinosa bowden vickers
+lowry pritchard costello piper mcclellan lovell sheehan hatch dobson singh
+jeffries hollingsworth sorensen meza fink donnelly burrell tomlinson
+colbert billings ritchie helton sutherland peoples mcqueen thomason givens
+crocker vogel robison dunham coker swartz keys ladner richter hargrove
+edmonds brantley albright murdock boswell muller quintero padgett kenney
+daly connolly inman quintana lund barnard villegas simons land huggins
+tidwell sanderson bullard mcclendon duarte draper marrero dwyer abrams
+stover goode fraser crews bernal godwin conklin mcneal baca esparza crowder
+bower brewster mcneill rodrigues leal coates raines mccain mccord miner
+holbrook swift dukes carlisle aldridge ackerman starks ricks holliday
+ferris hairston sheffield lange fountain doss betts kaplan carmichael bloom
+ruffin penn kern bowles sizemore larkin dupree seals metcalf hutchison
+henley farr mccauley hankins gustafson curran ash waddell ramey cates
+pollock cummins messer heller lin funk cornett palacios galindo cano
+hathaway singer pham enriquez salgado pelletier painter wiseman blount
+feliciano temple houser doherty mead mcgraw swan capps blanco blackmon
+thomson mcmanus burkett post gleason ott dickens cormier voss rushing
+rosenberg hurd dumas benitez arellano marin caudill bragg jaramillo huerta
+gipson colvin biggs vela platt cassidy tompkins mccollum dolan daley crump
+sneed kilgore grove grimm davison brunson prater marcum devine stratton
+rosas choi tripp ledbetter hightower feldman epps yeager posey scruggs cope
+stubbs richey overton trotter sprague cordero butcher stiles burgos woodson
+horner bassett purcell haskins akins ziegler spaulding hadley grubbs sumner
+murillo zavala shook lockwood driscoll dahl thorpe redmond putnam
+mcwilliams mcrae romano joiner sadler hedrick hager hagen fitch coulter
+thacker mansfield langston guidry ferreira corley conn rossi lackey baez
+saenz mcnamara mcmullen mckenna mcdonough link engel browne roper peacock
+eubanks drummond stringer pritchett parham mims landers ham grayson schafer
+egan timmons ohara keen hamlin finn cortes mcnair nadeau moseley michaud
+rosen oakes kurtz jeffers calloway beal bautista winn suggs stern stapleton
+lyles laird montano dawkins hagan goldman bryson barajas lovett segura metz
+lockett langford hinson eastman hooks smallwood shapiro crowell whalen
+triplett chatman aldrich cahill youngblood ybarra stallings sheets reeder
+connelly bateman abernathy winkler wilkes masters hackett granger gillis
+schmitz sapp napier souza lanier gomes weir otero ledford burroughs babcock
+ventura siegel dugan bledsoe atwood wray varner spangler anaya staley kraft
+fournier belanger wolff thorne bynum burnette boykin swenson purvis pina
+khan duvall darby xiong kauffman healy engle benoit valle steiner spicer
+shaver randle lundy dow chin calvert staton neff kearney darden oakley
+medeiros mccracken crenshaw block perdue dill whittaker tobin washburn
+hogue goodrich easley bravo dennison shipley kerns jorgensen crain
+villalobos maurer longoria keene coon witherspoon staples pettit kincaid
+eason madrid echols lusk stahl currie thayer shultz mcnally seay north
+maher gagne barrow nava moreland honeycutt hearn diggs caron whitten
+westbrook stovall ragland munson meier looney kimble jolly hobson goddard
+culver burr presley negron connell tovar huddleston ashby salter root
+pendleton oleary nickerson myrick judd jacobsen bain adair starnes matos
+busby herndon hanley bellamy doty bartley yazzie rowell parson gifford
+cullen christiansen benavides barnhart talbot mock crandall connors bonds
+whitt gage bergman arredondo addison lujan dowdy jernigan huynh bouchard
+dutton rhoades ouellette kiser herrington hare blackman babb allred rudd
+paulson ogden koenig geiger begay parra lassiter hawk esposito cho waldron
+ransom prather chacon vick sands roark parr mayberry greenberg coley bruner
+whitman skaggs shipman leary hutton romo medrano ladd kruse askew schulz
+alfaro tabor mohr gallo bermudez pereira bliss reaves flint comer woodall
+naquin guevara delong carrier pickens brand tilley schaffer lim knutson
+fenton doran chu vogt vann prescott mclain landis corcoran zapata hyatt
+hemphill faulk dove boudreaux aragon whitlock trejo tackett shearer saldana
+hanks mckinnon koehler bourgeois keyes goodson foote lunsford goldsmith
+flood winslow sams reagan mccloud hough esquivel naylor loomis coronado
+ludwig braswell bearden fagan ezell edmondson cyr cronin nunn lemon
+guillory grier dubose traylor ryder dobbins coyle aponte whitmore smalls
+rowan malloy cardona braxton borden humphries carrasco ruff metzger huntley
+hinojosa finney madsen hills ernst dozier burkhart bowser peralta daigle
+whittington sorenson saucedo roche redding fugate avalos waite lind huston
+hay hawthorne hamby boyles boles regan faust crook beam barger hinds
+gallardo willoughby willingham eckert busch zepeda worthington tinsley hoff
+hawley carmona varela rector newcomb kinsey dube whatley ragsdale bernstein
+becerra yost mattson felder cheek handy grossman gauthier escobedo braden
+beckman mott hillman flaherty dykes doe stockton stearns lofton coats
+cavazos beavers barrios parish mosher cardwell coles burnham weller lemons
+beebe aguilera parnell harman couture alley schumacher redd dobbs blum
+blalock merchant ennis denson cottrell brannon bagley aviles watt sousa
+rosenthal rooney dietz blank paquette mcclelland duff velasco lentz grubb
+burrows barbour ulrich shockley rader beyer mixon layton altman weathers
+stoner squires shipp priest lipscomb cutler caballero zimmer willett
+thurston storey medley epperson shah mcmillian baggett torrez laws hirsch
+dent poirier peachey farrar creech barth trimble dupre albrecht sample
+lawler crisp conroy wetzel nesbitt murry jameson wilhelm patten minton
+matson kimbrough iverson guinn croft toth pulliam nugent newby littlejohn
+dias canales bernier baron singletary renteria pruett mchugh mabry landrum
+brower stoddard cagle stjohn scales kohler kellogg hopson gant tharp gann
+zeigler pringle hammons fairchild deaton chavis carnes rowley matlock
+kearns irizarry carrington starkey lopes jarrell craven baum spain
+littlefield linn humphreys etheridge cuellar chastain bundy speer skelton
+quiroz pyle portillo ponder moulton machado liu killian hutson hitchcock
+dowling cloud burdick spann pedersen levin leggett hayward hacker dietrich
+beaulieu barksdale wakefield snowden briscoe bowie berman ogle mcgregor
+laughlin helm burden wheatley schreiber pressley parris alaniz agee urban
+swann snodgrass schuster radford monk mattingly harp girard cheney yancey
+wagoner
+`
@@ -0,0 +1,74 @@
+package pc
+
+import (
+	"testing"
+)
+
+var strengthPatternTests = []struct {
+	pass    string
+	pattern string
+}{
+	{"password", PatternDictionary},
+	{"drowssap", PatternDictionary},
+	{"p@ssw0rd", PatternDictionary},
+	{"hjkl;'", PatternSpatial},
+	{"123456789", PatternSequence},
+	{"zyxwvu", PatternSequence},
+	{"aaaaaaaa", PatternRepeat},
+	{"12/25/1991", PatternDate},
+	{"1991", PatternYear},
+}
+
+func TestEstimateStrengthPatterns(t *testing.T) {
+	for _, tt := range strengthPatternTests {
+		s := EstimateStrength(tt.pass)
+		if len(s.Sequence) != 1 {
+			t.Errorf("EstimateStrength(%q): expected one match, got %d", tt.pass, len(s.Sequence))
+			continue
+		}
+		if s.Sequence[0].Pattern != tt.pattern {
+			t.Errorf("EstimateStrength(%q): expected pattern %s, got %s", tt.pass, tt.pattern, s.Sequence[0].Pattern)
+		}
+		if s.Score > 1 {
+			t.Errorf("EstimateStrength(%q): expected a weak score, got %d", tt.pass, s.Score)
+		}
+	}
+}
+
+func TestEstimateStrengthRandom(t *testing.T) {
+	s := EstimateStrength("%L4^!s,Rry!}s:U<QwliL{vQ")
+	if s.Score != 4 {
+		t.Errorf("Random password should have a score of 4, got %d", s.Score)
+	}
+	if s.Warning != "" {
+		t.Errorf("Random password should not have a warning, got %q", s.Warning)
+	}
+}
+
+func TestEstimateStrengthUserInputs(t *testing.T) {
+	without := EstimateStrength("mybanksite")
+	with := EstimateStrength("mybanksite", "money/mybanksite.com")
+	if with.Guesses >= without.Guesses {
+		t.Errorf("Password containing the site name should be weaker: %f >= %f", with.Guesses, without.Guesses)
+	}
+}
+
+func TestEstimateStrengthEmpty(t *testing.T) {
+	s := EstimateStrength("")
+	if s.Score != 0 || s.Entropy != 0 {
+		t.Errorf("Empty password should have no strength, got score %d entropy %f", s.Score, s.Entropy)
+	}
+}
+
+func TestEstimateStrengthUnicode(t *testing.T) {
+	// The Kelvin sign lower cases to a shorter k, and an accented E to
+	// one of the same length.
+	for _, pass := range []string{"\u212a\u212a\u212a\u212a", "PASSWORD\u212a", "\u00c9CLAIRpassword", "drowssap\u212a\u00e9"} {
+		if s := EstimateStrength(pass); s.Guesses <= 0 {
+			t.Errorf("EstimateStrength(%q) estimated %v guesses", pass, s.Guesses)
+		}
+	}
+	if s := EstimateStrength("PASSWORD\u212a"); s.Score > 1 {
+		t.Errorf("Dictionary word next to a Kelvin sign scored %d", s.Score)
+	}
+}
@@ -1,20 +1,17 @@
 package pio
 
 import (
-	"bufio"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
-	"os/signal"
 	"os/user"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/atotto/clipboard"
-
-	"golang.org/x/crypto/ssh/terminal"
 )
 
 const (
@@ -25,6 +22,11 @@ const (
 	SiteFileName = "sites.json"
 	// EncryptedFileDir is the name of the passgo encrypted file dir.
 	EncryptedFileDir = "files"
+	// PolicyFileName is the name of the passgo password policy file.
+	PolicyFileName = "policies.json"
+	// KeyfileEnv is the environment variable that overrides the path of
+	// the keyfile in the config file.
+	KeyfileEnv = "PASSGO_KEYFILE"
 )
 
 var (
@@ -46,6 +48,21 @@ type ConfigFile struct {
 	MasterPassKeySalt   [32]byte
 	HmacSalt            [32]byte
 	SiteHmacSalt        [32]byte
+	// Keyfile is the path of a file that is needed as well as the master
+	// password to unlock the vault, if it has one.
+	Keyfile string `json:",omitempty"`
+}
+
+// KeyfilePath returns the path of the keyfile of the vault, which is
+// $PASSGO_KEYFILE if it is set. It is empty if the vault has no keyfile.
+func (c *ConfigFile) KeyfilePath() string {
+	if c.Keyfile == "" {
+		return ""
+	}
+	if path := os.Getenv(KeyfileEnv); path != "" {
+		return path
+	}
+	return c.Keyfile
 }
 
 // SiteInfo represents a single saved password entry.
@@ -55,6 +72,79 @@ type SiteInfo struct {
 	Name       string
 	FileName   string
 	IsFile     bool
+	// OrigName and Mode are the base name and permissions of the file
+	// that a file entry was inserted from.
+	OrigName string      `json:",omitempty"`
+	Mode     os.FileMode `json:",omitempty"`
+	// Streamed is set for file entries that are encrypted as a chunked
+	// stream rather than sealed all at once.
+	Streamed bool `json:",omitempty"`
+	// Compression is the algorithm a file entry was compressed with
+	// before it was encrypted, and Size is its size before compression.
+	Compression string `json:",omitempty"`
+	Size        int64  `json:",omitempty"`
+	// Created and Modified are when the site was added and when its
+	// password or contents last changed. They are unset for sites that
+	// were added by older versions of passgo.
+	Created  *time.Time `json:",omitempty"`
+	Modified *time.Time `json:",omitempty"`
+	// SSHPublicKey is set for SSH key entries, whose password is an
+	// OpenSSH private key. It is the public key in authorized_keys format.
+	SSHPublicKey string `json:",omitempty"`
+	Metadata
+
+	// pendingFile is the temporary file written by CreateFile that has
+	// not yet been moved in to place by CommitFile.
+	pendingFile string
+}
+
+// Metadata describes a site to help find it. Unlike passwords and files
+// it is stored in the vault unencrypted.
+type Metadata struct {
+	URL      string   `json:",omitempty"`
+	Username string   `json:",omitempty"`
+	Tags     []string `json:",omitempty"`
+}
+
+// IsEmpty reports whether m has no URL, username or tags.
+func (m Metadata) IsEmpty() bool {
+	return m.URL == "" && m.Username == "" && len(m.Tags) == 0
+}
+
+// Touch records that the site was just changed, and that it was created
+// now if it has no creation time yet.
+func (s *SiteInfo) Touch() {
+	now := time.Now().UTC().Truncate(time.Second)
+	if s.Created == nil {
+		s.Created = &now
+	}
+	s.Modified = &now
+}
+
+const (
+	// CompressionNone is used for file entries that are not compressed.
+	CompressionNone = ""
+	// CompressionGzip is used for file entries compressed with gzip.
+	CompressionGzip = "gzip"
+)
+
+// CleanPath removes leading, trailing and repeated slashes from a site
+// path or group.
+func CleanPath(p string) string {
+	parts := strings.Split(p, "/")
+	clean := parts[:0]
+	for _, part := range parts {
+		if part != "" {
+			clean = append(clean, part)
+		}
+	}
+	return strings.Join(clean, "/")
+}
+
+// InGroup reports whether the site called name is in group or in one of
+// its subgroups. Every site is in the group "".
+func InGroup(name, group string) bool {
+	return group == "" || strings.HasPrefix(name, group+"/")
 }
 
 // SiteFile represents the entire passgo password store.
@@ -175,35 +265,73 @@ func GetSitesFile() (d string, err error) {
 	return
 }
 
-func (s *SiteInfo) AddFile(fileBytes []byte, filename string) error {
+// GetPolicyFile will return the path to the user's password policies.
+func GetPolicyFile() (d string, err error) {
+	p, err := GetPassDir()
+	if err == nil {
+		d = filepath.Join(p, PolicyFileName)
+	}
+	return
+}
+
+// Sealed returns the sealed contents of the site. For file entries they
+// are read from the encrypted file dir.
+func (s *SiteInfo) Sealed() ([]byte, error) {
+	if !s.IsFile {
+		return s.PassSealed, nil
+	}
 	encFileDir, err := GetEncryptedFilesDir()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	// Make sure that the file directory exists.
-	fileDirExists, err := PassFileDirExists()
+	return ioutil.ReadFile(filepath.Join(encFileDir, s.FileName))
+}
+
+// CreateFile creates a temporary file next to the encrypted file of a file
+// entry so that it can be written to. The encrypted file is not replaced
+// until CommitFile is called, which should be done once the site has been
+// saved to the vault.
+func (s *SiteInfo) CreateFile() (*os.File, error) {
+	encFileDir, err := GetEncryptedFilesDir()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if !fileDirExists {
-		err = os.Mkdir(encFileDir, 0700)
-		if err != nil {
-			log.Fatalf("Could not create passgo encrypted file dir: %s", err.Error())
-		}
+	encFilePath := filepath.Join(encFileDir, s.FileName)
+	if err = os.MkdirAll(filepath.Dir(encFilePath), 0700); err != nil {
+		return nil, err
 	}
-	encFilePath := filepath.Join(encFileDir, filename)
-	dir, _ := filepath.Split(encFilePath)
-	err = os.MkdirAll(dir, 0700)
+	f, err := ioutil.TempFile(filepath.Dir(encFilePath), "."+filepath.Base(encFilePath)+".")
 	if err != nil {
-		log.Fatalf("Could not create subdirectory: %s", err.Error())
+		return nil, err
+	}
+	s.pendingFile = f.Name()
+	return f, nil
+}
+
+// CommitFile moves the file written by CreateFile over the encrypted file
+// of the file entry. It does nothing if there is no such file.
+func (s *SiteInfo) CommitFile() error {
+	if s.pendingFile == "" {
+		return nil
 	}
-	err = ioutil.WriteFile(encFilePath, fileBytes, 0666)
+	encFileDir, err := GetEncryptedFilesDir()
 	if err != nil {
 		return err
 	}
+	if err = os.Rename(s.pendingFile, filepath.Join(encFileDir, s.FileName)); err != nil {
+		return err
+	}
+	s.pendingFile = ""
+	return nil
+}
 
-	// We still need to add this site info to the bytes.
-	return s.AddSite()
+// DiscardFile removes the file written by CreateFile, leaving the
+// encrypted file of the file entry as it was.
+func (s *SiteInfo) DiscardFile() {
+	if s.pendingFile != "" {
+		os.Remove(s.pendingFile)
+		s.pendingFile = ""
+	}
 }
 
 // AddSite is used by individual password entries to update the vault.
@@ -307,40 +435,6 @@ func ReadConfig() (c ConfigFile, err error) {
 	return
 }
 
-// PromptPass will prompt user's for a password by terminal.
-func PromptPass(prompt string) (pass string, err error) {
-	// Make a copy of STDIN's state to restore afterward
-	fd := int(os.Stdin.Fd())
-	oldState, err := terminal.GetState(fd)
-	if err != nil {
-		panic("Could not get state of terminal: " + err.Error())
-	}
-	defer terminal.Restore(fd, oldState)
-
-	// Restore STDIN in the event of a signal interuption
-	sigch := make(chan os.Signal, 1)
-	signal.Notify(sigch, os.Interrupt)
-	go func() {
-		for _ = range sigch {
-			terminal.Restore(fd, oldState)
-			os.Exit(1)
-		}
-	}()
-
-	fmt.Printf("%s: ", prompt)
-	passBytes, err := terminal.ReadPassword(fd)
-	fmt.Println("")
-	return string(passBytes), err
-}
-
-// Prompt will prompt a user for regular data from stdin.
-func Prompt(prompt string) (s string, err error) {
-	fmt.Printf("%s", prompt)
-	stdin := bufio.NewReader(os.Stdin)
-	l, _, err := stdin.ReadLine()
-	return string(l), err
-}
-
 func ToClipboard(s string) {
 	if err := clipboard.WriteAll(s); err != nil {
 		log.Fatalf("Could not copy password to clipboard: %s", err.Error())
@@ -0,0 +1,249 @@
+package pio
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"strings"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	// MasterPassCommandEnv is the environment variable containing a command
+	// that prints the master password. It is used instead of prompting.
+	MasterPassCommandEnv = "PASSGO_MASTER_PASSWORD_COMMAND"
+)
+
+var (
+	// Input is the Prompter used by PromptPass and Prompt. It can be
+	// replaced to drive passgo without a terminal, for example in tests.
+	Input Prompter = NewTerminalPrompter()
+
+	// masterPassInput and secretInput replace prompting for the master
+	// password and for site secrets when they are set.
+	masterPassInput *bufio.Reader
+	secretInput     *bufio.Reader
+
+	// stdin is the only buffered reader of os.Stdin, so that input read
+	// ahead for one prompt is not lost to the next.
+	stdin = bufio.NewReader(os.Stdin)
+)
+
+// Prompter is used to ask the user for input.
+type Prompter interface {
+	// PromptPass asks for a secret that should not be echoed.
+	PromptPass(prompt string) (string, error)
+	// Prompt asks for regular data.
+	Prompt(prompt string) (string, error)
+}
+
+// StreamPrompter is a Prompter that writes prompts to Out and reads one
+// line of input per prompt from In.
+type StreamPrompter struct {
+	In  *bufio.Reader
+	Out io.Writer
+}
+
+// NewStreamPrompter returns a StreamPrompter reading answers from in and
+// writing prompts to out.
+func NewStreamPrompter(in io.Reader, out io.Writer) *StreamPrompter {
+	return &StreamPrompter{
+		In:  bufio.NewReader(in),
+		Out: out,
+	}
+}
+
+// PromptPass reads the next line from the stream.
+func (s *StreamPrompter) PromptPass(prompt string) (string, error) {
+	fmt.Fprintf(s.Out, "%s: ", prompt)
+	l, err := readLine(s.In)
+	fmt.Fprintln(s.Out, "")
+	return l, err
+}
+
+// Prompt reads the next line from the stream.
+func (s *StreamPrompter) Prompt(prompt string) (string, error) {
+	fmt.Fprintf(s.Out, "%s", prompt)
+	return readLine(s.In)
+}
+
+// TerminalPrompter prompts on stdin and stdout. Passwords are read without
+// echo when stdin is a terminal. Otherwise, such as when passgo is used in
+// a pipeline, lines are read from stdin and prompts are written to stderr.
+type TerminalPrompter struct {
+	stdin  *StreamPrompter
+	piped  *StreamPrompter
+	isTerm bool
+}
+
+// NewTerminalPrompter returns a TerminalPrompter for os.Stdin.
+func NewTerminalPrompter() *TerminalPrompter {
+	return &TerminalPrompter{
+		stdin:  &StreamPrompter{In: stdin, Out: os.Stdout},
+		piped:  &StreamPrompter{In: stdin, Out: os.Stderr},
+		isTerm: terminal.IsTerminal(int(os.Stdin.Fd())),
+	}
+}
+
+// PromptPass will prompt user's for a password by terminal.
+func (t *TerminalPrompter) PromptPass(prompt string) (pass string, err error) {
+	if !t.isTerm {
+		return t.piped.PromptPass(prompt)
+	}
+	// Make a copy of STDIN's state to restore afterward
+	fd := int(os.Stdin.Fd())
+	oldState, err := terminal.GetState(fd)
+	if err != nil {
+		return "", fmt.Errorf("Could not get state of terminal: %s", err.Error())
+	}
+	defer terminal.Restore(fd, oldState)
+
+	// Restore STDIN in the event of a signal interuption
+	sigch := make(chan os.Signal, 1)
+	signal.Notify(sigch, os.Interrupt)
+	defer signal.Stop(sigch)
+	go func() {
+		for _ = range sigch {
+			terminal.Restore(fd, oldState)
+			os.Exit(1)
+		}
+	}()
+
+	fmt.Printf("%s: ", prompt)
+	passBytes, err := terminal.ReadPassword(fd)
+	fmt.Println("")
+	return string(passBytes), err
+}
+
+// Prompt will prompt a user for regular data from stdin.
+func (t *TerminalPrompter) Prompt(prompt string) (string, error) {
+	if !t.isTerm {
+		return t.piped.Prompt(prompt)
+	}
+	return t.stdin.Prompt(prompt)
+}
+
+// readLine reads a line from r without the trailing newline. A final line
+// without a newline is returned without error.
+func readLine(r *bufio.Reader) (string, error) {
+	l, err := r.ReadString('\n')
+	if err == io.EOF && l != "" {
+		err = nil
+	}
+	return strings.TrimRight(l, "\r\n"), err
+}
+
+// PromptPass will prompt user's for a password using Input.
+func PromptPass(prompt string) (string, error) {
+	return Input.PromptPass(prompt)
+}
+
+// Prompt will prompt a user for regular data using Input.
+func Prompt(prompt string) (string, error) {
+	return Input.Prompt(prompt)
+}
+
+// SetMasterPassFD makes PromptMasterPass read the master password from
+// the next line of the already open file descriptor fd.
+func SetMasterPassFD(fd int) error {
+	f := os.NewFile(uintptr(fd), "master-password-fd")
+	if f == nil {
+		return fmt.Errorf("Invalid file descriptor %d", fd)
+	}
+	masterPassInput = bufferedReader(f)
+	return nil
+}
+
+// SetSecretInput makes PromptSecret and PromptMultiline read from r instead
+// of prompting. It is used to read secrets from stdin or from a file.
+func SetSecretInput(r io.Reader) {
+	secretInput = bufferedReader(r)
+}
+
+// bufferedReader returns the shared reader of os.Stdin if r is stdin, and
+// a new buffered reader of r otherwise.
+func bufferedReader(r io.Reader) *bufio.Reader {
+	if f, ok := r.(*os.File); ok && f.Fd() == os.Stdin.Fd() {
+		return stdin
+	}
+	return bufio.NewReader(r)
+}
+
+// PromptMasterPass gets the master password. It is read from the file
+// descriptor given to SetMasterPassFD, or the output of the command in
+// PASSGO_MASTER_PASSWORD_COMMAND, before falling back to prompting.
+func PromptMasterPass(prompt string) (string, error) {
+	if masterPassInput != nil {
+		return readLine(masterPassInput)
+	}
+	if command := os.Getenv(MasterPassCommandEnv); command != "" {
+		return runMasterPassCommand(command)
+	}
+	return PromptPass(prompt)
+}
+
+func runMasterPassCommand(command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("%s failed: %s", MasterPassCommandEnv, err.Error())
+	}
+	pass, _ := readLine(bufio.NewReader(strings.NewReader(string(out))))
+	if pass == "" {
+		return "", fmt.Errorf("%s printed an empty password", MasterPassCommandEnv)
+	}
+	return pass, nil
+}
+
+// PromptSecret gets a single line secret, such as a site password, from
+// the secret input if one was set, or by prompting.
+func PromptSecret(prompt string) (string, error) {
+	if secretInput != nil {
+		return readLine(secretInput)
+	}
+	return PromptPass(prompt)
+}
+
+// PromptMultiline gets a secret that may span several lines. It reads
+// the secret input if one was set, or stdin until EOF.
+func PromptMultiline(prompt string) (string, error) {
+	var in io.Reader = stdin
+	if secretInput != nil {
+		in = secretInput
+	} else if terminal.IsTerminal(int(os.Stdin.Fd())) {
+		fmt.Fprintf(os.Stderr, "%s and press Ctrl-D when finished:\n", prompt)
+	}
+	b, err := ioutil.ReadAll(in)
+	if err != nil {
+		return "", err
+	}
+	if len(b) == 0 {
+		return "", errors.New("No input was given")
+	}
+	return string(b), nil
+}
+
+// Confirm asks a yes or no question using Input and reports whether the
+// answer was yes.
+func Confirm(prompt string) (bool, error) {
+	answer, err := Prompt(prompt + " [y/N] ")
+	if err != nil {
+		return false, err
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
@@ -0,0 +1,77 @@
+package pio
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestStreamPrompter(t *testing.T) {
+	p := NewStreamPrompter(strings.NewReader("hunter2\r\nmoney/bank.com\nlast"), ioutil.Discard)
+	pass, err := p.PromptPass("Enter password")
+	if err != nil || pass != "hunter2" {
+		t.Fatalf("PromptPass: expected hunter2, actual %q %v", pass, err)
+	}
+	name, err := p.Prompt("Enter name: ")
+	if err != nil || name != "money/bank.com" {
+		t.Fatalf("Prompt: expected money/bank.com, actual %q %v", name, err)
+	}
+	last, err := p.Prompt("Enter name: ")
+	if err != nil || last != "last" {
+		t.Fatalf("Prompt: expected last, actual %q %v", last, err)
+	}
+	if _, err = p.Prompt("Enter name: "); err == nil {
+		t.Fatalf("Prompt at EOF did not return an error")
+	}
+}
+
+func TestPromptMasterPassCommand(t *testing.T) {
+	os.Setenv(MasterPassCommandEnv, "echo master; echo ignored")
+	defer os.Unsetenv(MasterPassCommandEnv)
+	pass, err := PromptMasterPass(MasterPassPrompt)
+	if err != nil || pass != "master" {
+		t.Fatalf("PromptMasterPass: expected master, actual %q %v", pass, err)
+	}
+}
+
+func TestPromptMasterPassFD(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Could not create pipe: %s", err)
+	}
+	w.WriteString("master\nsecond\n")
+	w.Close()
+	if err = SetMasterPassFD(int(r.Fd())); err != nil {
+		t.Fatalf("Could not set master password fd: %s", err)
+	}
+	defer func() { masterPassInput = nil }()
+	// Lines buffered by one prompt are there for the next.
+	for _, want := range []string{"master", "second"} {
+		pass, err := PromptMasterPass(MasterPassPrompt)
+		if err != nil || pass != want {
+			t.Fatalf("PromptMasterPass: expected %s, actual %q %v", want, pass, err)
+		}
+	}
+}
+
+func TestPromptSecretInput(t *testing.T) {
+	SetSecretInput(strings.NewReader("hunter2\nnotes\n"))
+	defer func() { secretInput = nil }()
+	pass, err := PromptSecret("Enter password")
+	if err != nil || pass != "hunter2" {
+		t.Fatalf("PromptSecret: expected hunter2, actual %q %v", pass, err)
+	}
+	rest, err := PromptMultiline("Enter contents")
+	if err != nil || rest != "notes\n" {
+		t.Fatalf("PromptMultiline: expected the rest of the input, actual %q %v", rest, err)
+	}
+}
+
+func TestStdinShared(t *testing.T) {
+	SetSecretInput(os.Stdin)
+	defer func() { secretInput = nil }()
+	if secretInput != stdin {
+		t.Errorf("Secrets from stdin do not share the prompter's reader")
+	}
+}
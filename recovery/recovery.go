@@ -0,0 +1,253 @@
+// Package recovery splits the master private key into shares that can
+// recover the vault when the master password is forgotten.
+package recovery
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+	"golang.org/x/crypto/curve25519"
+)
+
+const (
+	// shareLen is the length of an encoded share: the threshold, the x
+	// coordinate, one byte for every byte of the key and a checksum.
+	shareLen = 2 + 32 + checksumLen
+	// checksumLen is how much of the SHA-256 of a share is used to
+	// catch typos.
+	checksumLen = 2
+	// shareWords is how many words of the EFF long wordlist a share is
+	// written as. 7776^23 is more than 2^(8*shareLen).
+	shareWords = 23
+)
+
+var (
+	// qrEncoding only uses characters from the QR code alphanumeric mode.
+	qrEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	wordlist    []string
+	wordIndexes map[string]int
+)
+
+// Share is one of the pieces the master private key is split in to.
+type Share struct {
+	// Threshold is how many shares are needed to recover the key.
+	Threshold byte
+	// X identifies the share. It is never 0.
+	X byte
+	Y [32]byte
+}
+
+// SplitKey splits key in to n shares, any k of which recover it.
+func SplitKey(key *[32]byte, n, k int) ([]Share, error) {
+	points, err := split(key[:], n, k)
+	if err != nil {
+		return nil, err
+	}
+	shares := make([]Share, n)
+	for i, p := range points {
+		shares[i] = Share{Threshold: byte(k), X: p[0]}
+		copy(shares[i].Y[:], p[1:])
+		zero(p)
+	}
+	return shares, nil
+}
+
+// CombineKey recovers the key from at least as many shares as their
+// threshold.
+func CombineKey(shares []Share) (key [32]byte, err error) {
+	if len(shares) == 0 {
+		return key, errors.New("No shares were given")
+	}
+	points := make([][]byte, len(shares))
+	for i, s := range shares {
+		if s.Threshold != shares[0].Threshold {
+			return key, errors.New("The shares were not split together")
+		}
+		points[i] = append([]byte{s.X}, s.Y[:]...)
+		defer zero(points[i])
+	}
+	if len(shares) < int(shares[0].Threshold) {
+		return key, fmt.Errorf("%d shares are needed", shares[0].Threshold)
+	}
+	secret, err := combine(points)
+	if err != nil {
+		return key, err
+	}
+	copy(key[:], secret)
+	zero(secret)
+	return key, nil
+}
+
+func (s Share) bytes() []byte {
+	b := append([]byte{s.Threshold, s.X}, s.Y[:]...)
+	sum := sha256.Sum256(b)
+	return append(b, sum[:checksumLen]...)
+}
+
+// String returns the share in base32, which fits the QR code alphanumeric
+// mode.
+func (s Share) String() string {
+	return qrEncoding.EncodeToString(s.bytes())
+}
+
+// Words returns the share as words from the EFF long wordlist.
+func (s Share) Words() string {
+	loadWordlist()
+	n := new(big.Int).SetBytes(s.bytes())
+	base := big.NewInt(int64(len(wordlist)))
+	words := make([]string, shareWords)
+	for i := len(words) - 1; i >= 0; i-- {
+		var m big.Int
+		n.DivMod(n, base, &m)
+		words[i] = wordlist[m.Int64()]
+	}
+	return strings.Join(words, " ")
+}
+
+// ParseShare parses a share written by String or Words.
+func ParseShare(text string) (Share, error) {
+	var b []byte
+	fields := strings.Fields(text)
+	switch len(fields) {
+	case 0:
+		return Share{}, errors.New("The share is empty")
+	case 1:
+		var err error
+		if b, err = qrEncoding.DecodeString(strings.ToUpper(fields[0])); err != nil || len(b) != shareLen {
+			return Share{}, errors.New("The share is not valid base32 of the right length")
+		}
+	case shareWords:
+		var err error
+		if b, err = parseWords(fields); err != nil {
+			return Share{}, err
+		}
+	default:
+		return Share{}, fmt.Errorf("A share has %d words, not %d", shareWords, len(fields))
+	}
+	s := Share{Threshold: b[0], X: b[1]}
+	copy(s.Y[:], b[2:])
+	if string(s.bytes()) != string(b) {
+		return Share{}, errors.New("The share has a typo in it")
+	}
+	if s.Threshold < 2 || s.X == 0 {
+		return Share{}, errors.New("Invalid share")
+	}
+	return s, nil
+}
+
+func parseWords(words []string) ([]byte, error) {
+	loadWordlist()
+	n := new(big.Int)
+	base := big.NewInt(int64(len(wordlist)))
+	for _, w := range words {
+		i, ok := wordIndexes[strings.ToLower(w)]
+		if !ok {
+			return nil, fmt.Errorf("%s is not in the wordlist", w)
+		}
+		n.Mul(n, base).Add(n, big.NewInt(int64(i)))
+	}
+	if n.BitLen() > 8*shareLen {
+		return nil, errors.New("The share has a typo in it")
+	}
+	b := n.Bytes()
+	return append(make([]byte, shareLen-len(b)), b...), nil
+}
+
+func loadWordlist() {
+	if wordlist != nil {
+		return
+	}
+	wordlist = pc.Wordlist()
+	wordIndexes = make(map[string]int, len(wordlist))
+	for i, w := range wordlist {
+		wordIndexes[w] = i
+	}
+}
+
+// Split unlocks the vault and prints n shares of the master private key,
+// any k of which recover it. Shares are printed as words, or as strings
+// for QR codes if qr is set.
+func Split(n, k int, qr bool) {
+	key := pc.GetMasterKey()
+	shares, err := SplitKey(&key, n, k)
+	zero(key[:])
+	if err != nil {
+		log.Fatalf("Could not split master key: %s", err.Error())
+	}
+	fmt.Fprintf(os.Stderr, "Give each share to a different person. Any %d of them can unlock the vault without the master password.\n", k)
+	for i, s := range shares {
+		text := s.Words()
+		if qr {
+			text = s.String()
+		}
+		fmt.Printf("Share %d of %d:\n%s\n\n", i+1, n, text)
+	}
+}
+
+// Combine prompts for shares until there are enough to recover the master
+// private key, and then for a new master password to protect it with.
+func Combine() {
+	config, err := pio.ReadConfig()
+	if err != nil {
+		log.Fatalf("Could not read config file: %s", err.Error())
+	}
+	var shares []Share
+	for len(shares) == 0 || len(shares) < int(shares[0].Threshold) {
+		text, err := pio.Prompt(fmt.Sprintf("Share %d: ", len(shares)+1))
+		if err != nil {
+			log.Fatalf("Could not read share: %s", err.Error())
+		}
+		s, err := ParseShare(text)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Could not read share: %s\n", err.Error())
+			continue
+		}
+		shares = append(shares, s)
+	}
+	key, err := CombineKey(shares)
+	if err != nil {
+		log.Fatalf("Could not combine shares: %s", err.Error())
+	}
+	defer zero(key[:])
+	var pub [32]byte
+	curve25519.ScalarBaseMult(&pub, &key)
+	if pub != config.MasterPubKey {
+		log.Fatalf("Could not combine shares: %s", errors.New("They do not recover the master key of this vault"))
+	}
+
+	pass, err := pio.PromptPass("Please enter a new master password")
+	if err != nil {
+		log.Fatalf("Could not read password: %s", err.Error())
+	}
+	confirm, err := pio.PromptPass("Enter the new master password again")
+	if err != nil {
+		log.Fatalf("Could not read password: %s", err.Error())
+	}
+	if pass != confirm {
+		log.Fatalf("Could not set master password: %s", errors.New("The passwords do not match"))
+	}
+	// The shares stand in for the keyfile as well as the master
+	// password, so a lost keyfile is not needed any more.
+	if path := config.KeyfilePath(); path != "" {
+		if _, err = os.Stat(path); err != nil {
+			fmt.Fprintf(os.Stderr, "The keyfile %s could not be read, so the vault will not need it any more\n", path)
+			config.Keyfile = ""
+		}
+	}
+	if err = pc.SealMasterKey(pass, &key, &config); err != nil {
+		log.Fatalf("Could not encrypt master key: %s", err.Error())
+	}
+	if err = config.SaveFile(); err != nil {
+		log.Fatalf("Could not write to config file: %s", err.Error())
+	}
+	fmt.Println("The master password was changed")
+}
@@ -0,0 +1,78 @@
+package recovery
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/internal/vaulttest"
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+func TestParseShare(t *testing.T) {
+	var key [32]byte
+	key[0] = 1
+	shares, err := SplitKey(&key, 3, 2)
+	if err != nil {
+		t.Fatalf("Could not split: %s", err)
+	}
+	s := shares[1]
+	words := s.Words()
+	if n := len(strings.Fields(words)); n != shareWords {
+		t.Errorf("Share has %d words", n)
+	}
+	for _, text := range []string{words, strings.ToUpper(words), s.String(), strings.ToLower(s.String())} {
+		if got, err := ParseShare(text); err != nil || got != s {
+			t.Errorf("ParseShare(%q) = %v, %v", text, got, err)
+		}
+	}
+
+	typo := []byte(s.String())
+	typo[10] ^= 1
+	fields := strings.Fields(words)
+	fields[3] = "notaword"
+	for _, text := range []string{string(typo), strings.Join(fields, " "), strings.Join(fields[:5], " "), ""} {
+		if _, err := ParseShare(text); err == nil {
+			t.Errorf("ParseShare(%q) did not fail", text)
+		}
+	}
+
+	if _, err := CombineKey(shares[:1]); err == nil {
+		t.Errorf("Combined fewer shares than the threshold")
+	}
+	if got, err := CombineKey(shares[1:]); err != nil || got != key {
+		t.Errorf("CombineKey returned %x, %v", got, err)
+	}
+}
+
+func TestCombine(t *testing.T) {
+	_, cleanup := vaulttest.New(t)
+	defer cleanup()
+	vaulttest.AddPassword(t, "bank.com", "hunter2", pio.Metadata{})
+
+	key := vaulttest.Unlock()
+	shares, err := SplitKey(&key, 5, 3)
+	if err != nil {
+		t.Fatalf("Could not split: %s", err)
+	}
+	input := strings.Join([]string{
+		shares[4].Words(),
+		"not a share",
+		shares[0].String(),
+		shares[2].Words(),
+		"new", "new",
+	}, "\n") + "\n"
+	pio.Input = pio.NewStreamPrompter(strings.NewReader(input), ioutil.Discard)
+	Combine()
+
+	pio.Input = pio.NewStreamPrompter(strings.NewReader("new\n"), ioutil.Discard)
+	if got := pc.GetMasterKey(); got != key {
+		t.Fatalf("New master password unlocks a different key")
+	}
+	site := pio.GetVault()[0]
+	pass, err := pc.OpenAsym(site.PassSealed, &site.PubKey, &key)
+	if err != nil || string(pass) != "hunter2" {
+		t.Errorf("Could not open site after recovery: %q, %v", pass, err)
+	}
+}
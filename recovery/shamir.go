@@ -0,0 +1,108 @@
+package recovery
+
+import (
+	"crypto/rand"
+	"errors"
+)
+
+// split splits secret with Shamir's secret sharing over GF(256), so that
+// any k of the n shares recover it and fewer reveal nothing about it.
+// Share i is i+1 followed by a polynomial of degree k-1 evaluated at i+1
+// for every byte of secret, with that byte as the constant term.
+func split(secret []byte, n, k int) ([][]byte, error) {
+	if k < 2 || k > n || n > 255 {
+		return nil, errors.New("The threshold must be at least 2 and no more than the number of shares, which is at most 255")
+	}
+	shares := make([][]byte, n)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		shares[i][0] = byte(i + 1)
+	}
+	coeffs := make([]byte, k)
+	defer zero(coeffs)
+	for j, b := range secret {
+		coeffs[0] = b
+		if _, err := rand.Read(coeffs[1:]); err != nil {
+			return nil, err
+		}
+		for _, share := range shares {
+			share[j+1] = evaluate(coeffs, share[0])
+		}
+	}
+	return shares, nil
+}
+
+// combine recovers the secret from shares made by split. It needs at
+// least as many shares as the threshold they were split with, but can
+// not tell whether it got them.
+func combine(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, errors.New("At least two shares are needed")
+	}
+	size := len(shares[0])
+	for i, share := range shares {
+		if len(share) != size || size < 2 {
+			return nil, errors.New("The shares are not the same length")
+		}
+		if share[0] == 0 {
+			return nil, errors.New("Invalid share")
+		}
+		for _, other := range shares[:i] {
+			if other[0] == share[0] {
+				return nil, errors.New("The same share was given twice")
+			}
+		}
+	}
+	// Interpolate the polynomials at 0 using the Lagrange basis
+	// polynomials. Subtraction is xor in GF(256).
+	secret := make([]byte, size-1)
+	for i, share := range shares {
+		basis := byte(1)
+		for j, other := range shares {
+			if i != j {
+				basis = mul(basis, mul(other[0], inverse(other[0]^share[0])))
+			}
+		}
+		for k := range secret {
+			secret[k] ^= mul(share[k+1], basis)
+		}
+	}
+	return secret, nil
+}
+
+// evaluate returns the polynomial with coefficients coeffs, lowest degree
+// first, evaluated at x.
+func evaluate(coeffs []byte, x byte) byte {
+	var y byte
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y = mul(y, x) ^ coeffs[i]
+	}
+	return y
+}
+
+// mul multiplies in GF(256) with the AES polynomial x^8+x^4+x^3+x+1,
+// without branching on its arguments.
+func mul(a, b byte) byte {
+	var p byte
+	for i := 0; i < 8; i++ {
+		p ^= a & -(b & 1)
+		a = a<<1 ^ 0x1b&-(a>>7)
+		b >>= 1
+	}
+	return p
+}
+
+// inverse returns the multiplicative inverse of a, which is a^254.
+func inverse(a byte) byte {
+	b := a
+	for i := 0; i < 6; i++ {
+		b = mul(mul(b, b), a)
+	}
+	return mul(b, b)
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,78 @@
+package recovery
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestInverse(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		if p := mul(byte(a), inverse(byte(a))); p != 1 {
+			t.Fatalf("%d * inverse(%d) = %d", a, a, p)
+		}
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	secret := make([]byte, 32)
+	rand.Read(secret)
+	shares, err := split(secret, 5, 3)
+	if err != nil {
+		t.Fatalf("Could not split: %s", err)
+	}
+	// Every subset of at least 3 shares recovers the secret.
+	for set := 0; set < 1<<5; set++ {
+		var subset [][]byte
+		for i := range shares {
+			if set&(1<<uint(i)) != 0 {
+				subset = append(subset, shares[i])
+			}
+		}
+		if len(subset) < 3 {
+			continue
+		}
+		got, err := combine(subset)
+		if err != nil || !bytes.Equal(got, secret) {
+			t.Errorf("Shares %05b recovered %x, %v", set, got, err)
+		}
+	}
+
+	for _, nk := range [][2]int{{5, 1}, {2, 3}, {256, 3}} {
+		if _, err := split(secret, nk[0], nk[1]); err == nil {
+			t.Errorf("Split in to %d shares with threshold %d", nk[0], nk[1])
+		}
+	}
+	if _, err := combine([][]byte{shares[0], shares[0]}); err == nil {
+		t.Errorf("Combined a share with itself")
+	}
+}
+
+// With one share fewer than the threshold, every value of the secret is
+// still equally likely: each possible missing share gives a different one.
+func TestFewerSharesRevealNothing(t *testing.T) {
+	secret := []byte{42}
+	shares, err := split(secret, 3, 3)
+	if err != nil {
+		t.Fatalf("Could not split: %s", err)
+	}
+	seen := make(map[byte]bool)
+	for y := 0; y < 256; y++ {
+		got, err := combine([][]byte{shares[0], shares[1], {shares[2][0], byte(y)}})
+		if err != nil {
+			t.Fatalf("Could not combine: %s", err)
+		}
+		seen[got[0]] = true
+	}
+	if len(seen) != 256 {
+		t.Errorf("Two shares rule out %d secrets", 256-len(seen))
+	}
+
+	// Two shares of a threshold of three give a wrong secret.
+	secret = make([]byte, 32)
+	rand.Read(secret)
+	shares, _ = split(secret, 5, 3)
+	if got, _ := combine(shares[:2]); bytes.Equal(got, secret) {
+		t.Errorf("Two shares recovered the secret")
+	}
+}
@@ -0,0 +1,101 @@
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ejcx/passgo/v2/format"
+)
+
+// SocketEnv is the environment variable that overrides DefaultSocket.
+const SocketEnv = "PASSGO_SOCKET"
+
+// DefaultSocket returns where clients look for passgo serve --socket when
+// they are not told: $PASSGO_SOCKET, or passgo.sock in $XDG_RUNTIME_DIR.
+func DefaultSocket() string {
+	if socket := os.Getenv(SocketEnv); socket != "" {
+		return socket
+	}
+	dir := os.Getenv("XDG_RUNTIME_DIR")
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "passgo.sock")
+}
+
+// Client makes requests to passgo serve.
+type Client struct {
+	// BaseURL is where the server is, "http://passgo" for a socket.
+	BaseURL string
+	// Token is sent as a bearer token if set.
+	Token string
+	// UserAgent tells the server who is asking when it prompts for
+	// approval.
+	UserAgent string
+	HTTP      *http.Client
+}
+
+// NewClient returns a client for the server on the Unix socket at socket.
+func NewClient(socket string) *Client {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socket)
+		},
+	}
+	return &Client{BaseURL: "http://passgo", HTTP: &http.Client{Transport: transport}}
+}
+
+// List returns the sites in group, or in the whole vault if group is "".
+func (c *Client) List(group string) (sites []format.Site, err error) {
+	path := "/sites"
+	if group != "" {
+		path += "?group=" + url.QueryEscape(group)
+	}
+	err = c.get(path, &sites)
+	return
+}
+
+// Get returns the site called name along with its password.
+func (c *Client) Get(name string) (secret format.Secret, err error) {
+	segments := strings.Split(name, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	err = c.get("/sites/"+strings.Join(segments, "/"), &secret)
+	return
+}
+
+func (c *Client) get(path string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, c.BaseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var e Error
+		if err = json.NewDecoder(resp.Body).Decode(&e); err != nil || e.Error == "" {
+			return fmt.Errorf("passgo serve returned %s", resp.Status)
+		}
+		return errors.New(e.Error)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
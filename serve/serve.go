@@ -0,0 +1,339 @@
+// Package serve exposes the vault to local tools over HTTP, using the
+// JSON records from the format package.
+package serve
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ejcx/passgo/v2/edit"
+	"github.com/ejcx/passgo/v2/format"
+	"github.com/ejcx/passgo/v2/insert"
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// DefaultAddr is the loopback address served on when no socket is given.
+const DefaultAddr = "127.0.0.1:7743"
+
+// Approver is asked before a secret is returned to a client. name is the
+// full path of the site being read.
+type Approver func(r *http.Request, name string) bool
+
+// Server answers API requests using the unlocked master private key.
+//
+// The API is:
+//
+//	GET  /sites?group=g   list the sites in the vault, or in group g
+//	GET  /sites/<name>    get a site along with its password
+//	POST /sites           add a password, given a NewSite
+//	PUT  /sites/<name>    change the password of a site, given a Change
+type Server struct {
+	masterPrivKey [32]byte
+	// Token, if set, must be sent by clients as a bearer token.
+	Token string
+	// Approve, if set, is asked before every secret is returned.
+	Approve Approver
+
+	// mu serializes requests, since each one reads and rewrites the
+	// vault.
+	mu sync.Mutex
+}
+
+// NewSite is the body of a request to add a password.
+type NewSite struct {
+	Name     string   `json:"name"`
+	Password string   `json:"password"`
+	URL      string   `json:"url,omitempty"`
+	Username string   `json:"username,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+}
+
+// Change is the body of a request to change a password.
+type Change struct {
+	Password string `json:"password"`
+}
+
+// Error is the body of every response that is not a success.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// New returns a server for the vault unlocked with masterPrivKey.
+func New(masterPrivKey [32]byte) *Server {
+	return &Server{masterPrivKey: masterPrivKey}
+}
+
+// Serve unlocks the vault and answers requests on the Unix socket at
+// socket, or on the loopback address addr if socket is empty. Clients of
+// addr must send the token read from tokenFile, or the one printed at
+// startup. Unless prompt is false, every secret that is read has to be
+// approved on the terminal first.
+func Serve(socket, addr, tokenFile string, prompt bool) error {
+	var l net.Listener
+	var err error
+	s := New(pc.GetMasterKey())
+	if prompt {
+		s.Approve = terminalApprover()
+	}
+	if socket != "" {
+		l, err = listenUnix(socket)
+	} else {
+		if err = checkLoopback(addr); err != nil {
+			return err
+		}
+		if s.Token, err = readToken(tokenFile); err != nil {
+			return err
+		}
+		l, err = net.Listen("tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("Serving the vault on %s", l.Addr())
+
+	srv := &http.Server{Handler: s}
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		srv.Close()
+	}()
+	if err = srv.Serve(l); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// listenUnix listens on a socket that only the current user can connect
+// to. A socket left behind by a server that did not exit cleanly is
+// replaced.
+func listenUnix(socket string) (net.Listener, error) {
+	if fi, err := os.Lstat(socket); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		if c, err := net.Dial("unix", socket); err == nil {
+			c.Close()
+			return nil, fmt.Errorf("%s is already being served", socket)
+		}
+		os.Remove(socket)
+	}
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.Chmod(socket, 0600); err != nil {
+		l.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// checkLoopback returns an error unless addr can only be reached from
+// this machine.
+func checkLoopback(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return fmt.Errorf("%s is not a loopback address", addr)
+	}
+	return nil
+}
+
+// readToken returns the token in tokenFile, or a new random token that is
+// printed for the user if tokenFile is empty.
+func readToken(tokenFile string) (string, error) {
+	if tokenFile != "" {
+		b, err := ioutil.ReadFile(tokenFile)
+		if err != nil {
+			return "", err
+		}
+		token := strings.TrimSpace(string(b))
+		if token == "" {
+			return "", fmt.Errorf("%s is empty", tokenFile)
+		}
+		return token, nil
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+	fmt.Fprintf(os.Stderr, "Token: %s\n", token)
+	return token, nil
+}
+
+// terminalApprover asks on the terminal, one request at a time.
+func terminalApprover() Approver {
+	var mu sync.Mutex
+	return func(r *http.Request, name string) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		client := r.RemoteAddr
+		if client == "" || client == "@" {
+			client = "a local client"
+		}
+		if ua := r.UserAgent(); ua != "" {
+			client += " (" + ua + ")"
+		}
+		ok, err := pio.Confirm(fmt.Sprintf("Allow %s to read %s?", client, name))
+		return err == nil && ok
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.Token != "" {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "Bearer ") || subtle.ConstantTimeCompare([]byte(auth[len("Bearer "):]), []byte(s.Token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errors.New("Missing or wrong token"))
+			return
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := ""
+	if r.URL.Path != "/sites" {
+		if !strings.HasPrefix(r.URL.Path, "/sites/") {
+			writeError(w, http.StatusNotFound, fmt.Errorf("Unknown path %s", r.URL.Path))
+			return
+		}
+		name = pio.CleanPath(strings.TrimPrefix(r.URL.Path, "/sites/"))
+	}
+	switch {
+	case name == "" && r.Method == http.MethodGet:
+		s.list(w, r)
+	case name == "" && r.Method == http.MethodPost:
+		s.insert(w, r)
+	case name != "" && r.Method == http.MethodGet:
+		s.get(w, r, name)
+	case name != "" && r.Method == http.MethodPut:
+		s.change(w, r, name)
+	default:
+		w.Header().Set("Allow", "GET, POST, PUT")
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("%s is not allowed on %s", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+	group := pio.CleanPath(r.URL.Query().Get("group"))
+	sites := []format.Site{}
+	for _, site := range pio.GetVault() {
+		if group == "" || pio.InGroup(site.Name, group) {
+			sites = append(sites, format.NewSite(site))
+		}
+	}
+	sort.Slice(sites, func(i, j int) bool {
+		return sites[i].Name < sites[j].Name
+	})
+	writeJSON(w, http.StatusOK, sites)
+}
+
+func (s *Server) get(w http.ResponseWriter, r *http.Request, name string) {
+	site, ok := findSite(name)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("Site %s not found", name))
+		return
+	}
+	if s.Approve != nil && !s.Approve(r, name) {
+		writeError(w, http.StatusForbidden, fmt.Errorf("Reading %s was not approved", name))
+		return
+	}
+	rc, err := pc.OpenSite(&site, &s.masterPrivKey)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("Could not decrypt %s: %s", name, err.Error()))
+		return
+	}
+	contents, err := ioutil.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("Could not decrypt %s: %s", name, err.Error()))
+		return
+	}
+	writeJSON(w, http.StatusOK, format.NewSecret(site, contents))
+}
+
+func (s *Server) insert(w http.ResponseWriter, r *http.Request) {
+	var req NewSite
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("Could not decode request: %s", err.Error()))
+		return
+	}
+	name := pio.CleanPath(req.Name)
+	if name == "" || req.Password == "" {
+		writeError(w, http.StatusBadRequest, errors.New("A name and password are required"))
+		return
+	}
+	if _, ok := findSite(name); ok {
+		writeError(w, http.StatusConflict, fmt.Errorf("Site %s already exists", name))
+		return
+	}
+	err := insert.SealPassword(name, req.Password, pio.Metadata{URL: req.URL, Username: req.Username, Tags: req.Tags})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("Could not add %s: %s", name, err.Error()))
+		return
+	}
+	site, _ := findSite(name)
+	writeJSON(w, http.StatusCreated, format.NewSite(site))
+}
+
+func (s *Server) change(w http.ResponseWriter, r *http.Request, name string) {
+	var req Change
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("Could not decode request: %s", err.Error()))
+		return
+	}
+	site, ok := findSite(name)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("Site %s not found", name))
+		return
+	}
+	if site.IsFile || site.SSHPublicKey != "" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("%s is not a password", name))
+		return
+	}
+	if req.Password == "" {
+		writeError(w, http.StatusBadRequest, errors.New("A password is required"))
+		return
+	}
+	if err := edit.Replace(name, req.Password); err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("Could not change the password of %s: %s", name, err.Error()))
+		return
+	}
+	site, _ = findSite(name)
+	writeJSON(w, http.StatusOK, format.NewSite(site))
+}
+
+func findSite(name string) (pio.SiteInfo, bool) {
+	for _, site := range pio.GetVault() {
+		if site.Name == name {
+			return site, true
+		}
+	}
+	return pio.SiteInfo{}, false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	format.JSON.Write(w, v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, Error{err.Error()})
+}
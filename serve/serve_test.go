@@ -0,0 +1,223 @@
+package serve
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/format"
+	"github.com/ejcx/passgo/v2/internal/vaulttest"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+const token = "s3cret-token"
+
+// newServer creates a vault holding a few sites and serves it with
+// httptest. Reads are approved unless *deny is set.
+func newServer(t *testing.T, deny *bool) (ts *httptest.Server, cleanup func()) {
+	_, removeVault := vaulttest.New(t)
+	vaulttest.AddPassword(t, "money/bank.com", "hunter2", pio.Metadata{Username: "alice"})
+	vaulttest.AddPassword(t, "email/gmail.com", "gmailpass", pio.Metadata{})
+
+	s := New(vaulttest.Unlock())
+	s.Token = token
+	s.Approve = func(r *http.Request, name string) bool {
+		return !*deny
+	}
+	ts = httptest.NewServer(s)
+	return ts, func() {
+		ts.Close()
+		removeVault()
+	}
+}
+
+// do sends a request with the token and decodes the response into v.
+func do(t *testing.T, ts *httptest.Server, method, path string, body, v interface{}) int {
+	var b bytes.Buffer
+	if body != nil {
+		json.NewEncoder(&b).Encode(body)
+	}
+	req, err := http.NewRequest(method, ts.URL+path, &b)
+	if err != nil {
+		t.Fatalf("Could not create request: %s", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := ts.Client().Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %s", method, path, err)
+	}
+	defer resp.Body.Close()
+	if v != nil {
+		if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatalf("Could not decode %s %s: %s", method, path, err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestToken(t *testing.T) {
+	var deny bool
+	ts, cleanup := newServer(t, &deny)
+	defer cleanup()
+
+	for _, auth := range []string{"", "Bearer wrong", token} {
+		req, _ := http.NewRequest("GET", ts.URL+"/sites", nil)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		resp, err := ts.Client().Do(req)
+		if err != nil {
+			t.Fatalf("GET /sites failed: %s", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("Authorization %q: status %d, expected 401", auth, resp.StatusCode)
+		}
+	}
+}
+
+func TestListAndGet(t *testing.T) {
+	var deny bool
+	ts, cleanup := newServer(t, &deny)
+	defer cleanup()
+
+	var sites []format.Site
+	if code := do(t, ts, "GET", "/sites", nil, &sites); code != http.StatusOK {
+		t.Fatalf("GET /sites: status %d", code)
+	}
+	if len(sites) != 2 || sites[0].Name != "email/gmail.com" || sites[1].Username != "alice" {
+		t.Fatalf("GET /sites returned %+v", sites)
+	}
+	sites = nil
+	do(t, ts, "GET", "/sites?group=money", nil, &sites)
+	if len(sites) != 1 || sites[0].Name != "money/bank.com" {
+		t.Fatalf("GET /sites?group=money returned %+v", sites)
+	}
+
+	var secret format.Secret
+	if code := do(t, ts, "GET", "/sites/money/bank.com", nil, &secret); code != http.StatusOK {
+		t.Fatalf("GET /sites/money/bank.com: status %d", code)
+	}
+	if secret.Secret != "hunter2" || secret.Username != "alice" {
+		t.Errorf("GET /sites/money/bank.com returned %+v", secret)
+	}
+
+	var e Error
+	if code := do(t, ts, "GET", "/sites/money/missing.com", nil, &e); code != http.StatusNotFound || e.Error == "" {
+		t.Errorf("GET missing site: status %d, error %q", code, e.Error)
+	}
+	deny = true
+	if code := do(t, ts, "GET", "/sites/money/bank.com", nil, &e); code != http.StatusForbidden {
+		t.Errorf("GET denied site: status %d", code)
+	}
+	if code := do(t, ts, "DELETE", "/sites/money/bank.com", nil, &e); code != http.StatusMethodNotAllowed {
+		t.Errorf("DELETE: status %d", code)
+	}
+}
+
+func TestInsertAndChange(t *testing.T) {
+	var deny bool
+	ts, cleanup := newServer(t, &deny)
+	defer cleanup()
+
+	var site format.Site
+	req := NewSite{Name: "work/vpn", Password: "vpnpass", URL: "https://vpn.example.com"}
+	if code := do(t, ts, "POST", "/sites", req, &site); code != http.StatusCreated {
+		t.Fatalf("POST /sites: status %d", code)
+	}
+	if site.Name != "work/vpn" || site.URL != "https://vpn.example.com" || site.Created == nil {
+		t.Errorf("POST /sites returned %+v", site)
+	}
+	var e Error
+	if code := do(t, ts, "POST", "/sites", req, &e); code != http.StatusConflict {
+		t.Errorf("POST duplicate: status %d", code)
+	}
+	if code := do(t, ts, "POST", "/sites", NewSite{Name: "work/empty"}, &e); code != http.StatusBadRequest {
+		t.Errorf("POST without password: status %d", code)
+	}
+	// The server answers with an error instead of exiting when the site
+	// can not be saved.
+	restore := vaulttest.BreakConfig(t)
+	if code := do(t, ts, "POST", "/sites", NewSite{Name: "work/wifi", Password: "x"}, &e); code != http.StatusInternalServerError || e.Error == "" {
+		t.Errorf("POST with a broken config: status %d, error %q", code, e.Error)
+	}
+	restore()
+
+	if code := do(t, ts, "PUT", "/sites/work/vpn", Change{Password: "newpass"}, &site); code != http.StatusOK {
+		t.Fatalf("PUT /sites/work/vpn: status %d", code)
+	}
+	var secret format.Secret
+	do(t, ts, "GET", "/sites/work/vpn", nil, &secret)
+	if secret.Secret != "newpass" || secret.URL != "https://vpn.example.com" {
+		t.Errorf("Site after PUT is %+v", secret)
+	}
+	if code := do(t, ts, "PUT", "/sites/work/missing", Change{Password: "x"}, &e); code != http.StatusNotFound {
+		t.Errorf("PUT missing site: status %d", code)
+	}
+}
+
+func TestCheckLoopback(t *testing.T) {
+	for addr, ok := range map[string]bool{
+		"127.0.0.1:7743": true,
+		"[::1]:7743":     true,
+		"localhost:7743": true,
+		"0.0.0.0:7743":   false,
+		":7743":          false,
+		"10.0.0.1:7743":  false,
+	} {
+		if err := checkLoopback(addr); (err == nil) != ok {
+			t.Errorf("checkLoopback(%q) returned %v", addr, err)
+		}
+	}
+}
+
+func TestClient(t *testing.T) {
+	var deny bool
+	ts, cleanup := newServer(t, &deny)
+	defer cleanup()
+	ts.Close()
+
+	// Serve the same vault on a Unix socket, like passgo serve --socket,
+	// which does not use a token.
+	s := ts.Config.Handler.(*Server)
+	s.Token = ""
+	socket := filepath.Join(os.Getenv(pio.PASSGODIR), "serve.sock")
+	l, err := listenUnix(socket)
+	if err != nil {
+		t.Fatalf("Could not listen on %s: %s", socket, err)
+	}
+	srv := &http.Server{Handler: s}
+	go srv.Serve(l)
+	defer srv.Close()
+
+	c := NewClient(socket)
+	sites, err := c.List("money")
+	if err != nil || len(sites) != 1 || sites[0].Name != "money/bank.com" {
+		t.Fatalf("List returned %+v, %v", sites, err)
+	}
+	secret, err := c.Get("money/bank.com")
+	if err != nil || secret.Secret != "hunter2" {
+		t.Fatalf("Get returned %+v, %v", secret, err)
+	}
+	// Names are escaped, so characters such as ? and # reach the server.
+	vaulttest.AddPassword(t, "work/vpn?#1 %", "vpnpass", pio.Metadata{})
+	secret, err = c.Get("work/vpn?#1 %")
+	if err != nil || secret.Secret != "vpnpass" {
+		t.Fatalf("Get of a name with special characters returned %+v, %v", secret, err)
+	}
+	deny = true
+	if _, err = c.Get("money/bank.com"); err == nil || !strings.Contains(err.Error(), "not approved") {
+		t.Fatalf("Get of a denied site returned %v", err)
+	}
+	if _, err = listenUnix(socket); err == nil {
+		t.Fatalf("Listened on a socket that is already being served")
+	}
+	if _, err = NewClient(socket + ".missing").List(""); err == nil {
+		t.Fatalf("List without a server did not fail")
+	}
+}
@@ -0,0 +1,131 @@
+package show
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+const (
+	// defaultFileMode is used for files that were inserted before
+	// passgo recorded their mode.
+	defaultFileMode = 0600
+)
+
+// SiteToFile will decrypt the site that matches path and write it to
+// output instead of stdout. If output is a directory the file is written
+// inside of it using the name of the file that was originally inserted.
+func SiteToFile(sitePath, output string) {
+	var site *pio.SiteInfo
+	vault := pio.GetVault()
+	for i := range vault {
+		if vault[i].Name == sitePath {
+			site = &vault[i]
+			break
+		}
+	}
+	if site == nil {
+		log.Fatalf("Site with path %s not found", sitePath)
+	}
+	if fi, err := os.Stat(output); err == nil && fi.IsDir() {
+		output = filepath.Join(output, originalName(*site))
+	}
+	masterPrivKey := pc.GetMasterKey()
+	if err := writeSite(*site, masterPrivKey, output, true); err != nil {
+		log.Fatalf("Could not write %s: %s", sitePath, err.Error())
+	}
+}
+
+// Extract will decrypt every file entry in group and write them to dir,
+// using the names and permissions the files had when they were inserted.
+// Existing files are only replaced if overwrite is set.
+func Extract(group, dir string, overwrite bool) {
+	group = pio.CleanPath(group)
+	var sites []pio.SiteInfo
+	for _, site := range pio.GetVault() {
+		if site.IsFile && pio.InGroup(site.Name, group) {
+			sites = append(sites, site)
+		}
+	}
+	if len(sites) == 0 {
+		log.Fatalf("No file entries found in %s", group)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		log.Fatalf("Could not create %s: %s", dir, err.Error())
+	}
+	masterPrivKey := pc.GetMasterKey()
+	var allErrors []error
+	for _, site := range sites {
+		rel := strings.TrimPrefix(site.Name, group+"/")
+		output := filepath.Join(dir, filepath.FromSlash(path.Dir(rel)), originalName(site))
+		// Names come from the vault, which may have been tampered with,
+		// so never write outside of dir.
+		if !insideDir(dir, output) {
+			allErrors = append(allErrors, fmt.Errorf("%s: refusing to write outside of %s", site.Name, dir))
+			continue
+		}
+		if err := writeSite(site, masterPrivKey, output, overwrite); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("%s: %s", site.Name, err.Error()))
+			continue
+		}
+		fmt.Println(output)
+	}
+	handleErrors(allErrors)
+}
+
+// insideDir reports whether p is a path below dir.
+func insideDir(dir, p string) bool {
+	rel, err := filepath.Rel(dir, p)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// originalName returns the name of the file a site was inserted from.
+func originalName(site pio.SiteInfo) string {
+	if site.OrigName != "" {
+		return filepath.Base(site.OrigName)
+	}
+	return path.Base(site.Name)
+}
+
+// writeSite decrypts site and writes it to output with the mode the file
+// was inserted with. A partially written output is removed on error.
+func writeSite(site pio.SiteInfo, masterPrivKey [32]byte, output string, overwrite bool) error {
+	r, err := pc.OpenSite(&site, &masterPrivKey)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	mode := site.Mode
+	if mode == 0 {
+		mode = defaultFileMode
+	}
+	if err = os.MkdirAll(filepath.Dir(output), 0700); err != nil {
+		return err
+	}
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !overwrite {
+		flags |= os.O_EXCL
+	}
+	f, err := os.OpenFile(output, flags, mode)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(output)
+	}
+	return err
+}
@@ -0,0 +1,29 @@
+package show
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestInsideDir(t *testing.T) {
+	for _, tc := range []struct {
+		dir, p string
+		want   bool
+	}{
+		{".", "a", true},
+		{".", filepath.Join("a", "b"), true},
+		{"out", filepath.Join("out", "a"), true},
+		{"out/", filepath.Join("out", "a", "b"), true},
+		{".", "..a", true},
+		{".", ".", false},
+		{"out", "out", false},
+		{".", "..", false},
+		{".", filepath.Join("..", "a"), false},
+		{"out", filepath.Join("out", "..", "a"), false},
+		{"out", "outside", false},
+	} {
+		if got := insideDir(tc.dir, tc.p); got != tc.want {
+			t.Errorf("insideDir(%q, %q) = %v, want %v", tc.dir, tc.p, got, tc.want)
+		}
+	}
+}
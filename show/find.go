@@ -0,0 +1,192 @@
+package show
+
+import (
+	"log"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/ejcx/passgo/v2/format"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// Scores given to parts of a fuzzy match, loosely following fzf.
+const (
+	scoreMatch       = 16
+	bonusBoundary    = 8
+	bonusConsecutive = 4
+	// The first character of the pattern counts double, since it is
+	// usually the start of what the user is looking for.
+	bonusFirstCharMultiplier = 2
+	penaltyGapStart          = 3
+	penaltyGapExtend         = 1
+)
+
+// FindOptions changes how Find matches sites.
+type FindOptions struct {
+	// Regex treats the query as a regular expression instead of a fuzzy
+	// pattern.
+	Regex bool
+	// CaseSensitive stops upper and lower case letters from matching
+	// each other.
+	CaseSensitive bool
+}
+
+// Find will search the vault for sites whose path fuzzily matches query,
+// or whose URL, username or tags contain it. With SortScore the best
+// matches are listed first.
+func Find(query string, opts FindOptions, order SortOrder, f format.Format) {
+	match, err := NewMatcher(query, opts)
+	if err != nil {
+		log.Fatalf("Could not search for %s: %s", query, err.Error())
+	}
+	allSites := map[string][]pio.SiteInfo{}
+	scores := map[string]int{}
+	for _, site := range pio.GetVault() {
+		score, ok := match(site)
+		if !ok {
+			continue
+		}
+		group, name := "", site.Name
+		if i := strings.LastIndex(site.Name, "/"); i > 0 {
+			group, name = site.Name[:i], site.Name[i+1:]
+		}
+		scores[site.Name] = score
+		site.Name = name
+		allSites[group] = append(allSites[group], site)
+	}
+	showResults(allSites, order, f, scores)
+}
+
+// NewMatcher returns a func that reports whether a site matches query,
+// and how well, the same way Find does.
+func NewMatcher(query string, opts FindOptions) (func(pio.SiteInfo) (int, bool), error) {
+	fields := func(site pio.SiteInfo) []string {
+		return append([]string{site.URL, site.Username}, site.Tags...)
+	}
+	if opts.Regex {
+		if !opts.CaseSensitive {
+			query = "(?i)" + query
+		}
+		re, err := regexp.Compile(query)
+		if err != nil {
+			return nil, err
+		}
+		return func(site pio.SiteInfo) (int, bool) {
+			if re.MatchString(site.Name) {
+				return 0, true
+			}
+			for _, field := range fields(site) {
+				if field != "" && re.MatchString(field) {
+					return 0, true
+				}
+			}
+			return 0, false
+		}, nil
+	}
+
+	fold := func(s string) string {
+		if opts.CaseSensitive {
+			return s
+		}
+		return strings.ToLower(s)
+	}
+	pattern := []rune(fold(query))
+	return func(site pio.SiteInfo) (int, bool) {
+		best, ok := fuzzyScore(pattern, []rune(fold(site.Name)))
+		for _, field := range fields(site) {
+			// Metadata such as URLs is long and noisy, so it has to
+			// contain the whole query.
+			field = fold(field)
+			if !strings.Contains(field, string(pattern)) {
+				continue
+			}
+			if score, _ := fuzzyScore(pattern, []rune(field)); !ok || score > best {
+				best, ok = score, true
+			}
+		}
+		return best, ok
+	}, nil
+}
+
+// fuzzyScore reports whether pattern is a subsequence of s and scores the
+// match. Matches score higher when they are consecutive and when they
+// start a word, and lower for every gap between them. The shortest
+// window of s containing the match is scored.
+func fuzzyScore(pattern, s []rune) (int, bool) {
+	if len(pattern) == 0 {
+		return 0, true
+	}
+	// Find where the first match ends...
+	end, pi := -1, 0
+	for i, r := range s {
+		if r == pattern[pi] {
+			pi++
+			if pi == len(pattern) {
+				end = i
+				break
+			}
+		}
+	}
+	if end < 0 {
+		return 0, false
+	}
+	// ...then walk back to the latest start that still matches.
+	start := end
+	for pi = len(pattern) - 1; ; start-- {
+		if s[start] == pattern[pi] {
+			pi--
+			if pi < 0 {
+				break
+			}
+		}
+	}
+
+	score, pi, runBonus := 0, 0, 0
+	consecutive, inGap := false, false
+	for i := start; i <= end; i++ {
+		if pi < len(pattern) && s[i] == pattern[pi] {
+			bonus := 0
+			if i == 0 || isBoundary(s[i-1]) {
+				bonus = bonusBoundary
+			}
+			// A run of consecutive matches keeps the bonus of the
+			// character it started with.
+			if consecutive {
+				bonus = maxInt(bonus, runBonus, bonusConsecutive)
+			} else {
+				runBonus = bonus
+			}
+			if pi == 0 {
+				bonus *= bonusFirstCharMultiplier
+			}
+			score += scoreMatch + bonus
+			pi++
+			consecutive, inGap = true, false
+			continue
+		}
+		if inGap {
+			score -= penaltyGapExtend
+		} else {
+			score -= penaltyGapStart
+		}
+		consecutive, inGap = false, true
+	}
+	return score, true
+}
+
+// maxInt returns the largest of a.
+func maxInt(a ...int) int {
+	m := a[0]
+	for _, n := range a[1:] {
+		if n > m {
+			m = n
+		}
+	}
+	return m
+}
+
+// isBoundary reports whether r separates words in a site name.
+func isBoundary(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
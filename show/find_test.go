@@ -0,0 +1,81 @@
+package show
+
+import (
+	"testing"
+
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	tests := []struct {
+		pattern, s string
+		matches    bool
+	}{
+		{"mnt", "money/mint.com", true},
+		{"mint", "money/mint.com", true},
+		{"", "anything", true},
+		{"tnm", "money/mint.com", false},
+		{"mintt", "money/mint.com", false},
+	}
+	for _, tt := range tests {
+		if _, ok := fuzzyScore([]rune(tt.pattern), []rune(tt.s)); ok != tt.matches {
+			t.Errorf("fuzzyScore(%q, %q) matched: %t, expected %t", tt.pattern, tt.s, ok, tt.matches)
+		}
+	}
+
+	// Each pattern should rank the first string above the second.
+	rankings := []struct {
+		pattern, better, worse string
+	}{
+		{"mint", "money/mint.com", "money/my-internet"},
+		{"mnt", "money/mint.com", "money/sub/bin.dat"},
+		{"aws", "work/aws/prod", "work/always"},
+		{"prod", "work/prod", "work/p/r/o/d"},
+	}
+	for _, tt := range rankings {
+		better, ok1 := fuzzyScore([]rune(tt.pattern), []rune(tt.better))
+		worse, ok2 := fuzzyScore([]rune(tt.pattern), []rune(tt.worse))
+		if !ok1 || !ok2 || better <= worse {
+			t.Errorf("%q: %q scored %d and %q scored %d", tt.pattern, tt.better, better, tt.worse, worse)
+		}
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	site := pio.SiteInfo{
+		Name: "Work/Console",
+		Metadata: pio.Metadata{
+			URL:      "https://console.aws.amazon.com",
+			Username: "ops",
+			Tags:     []string{"cloud"},
+		},
+	}
+	tests := []struct {
+		query   string
+		opts    FindOptions
+		matches bool
+	}{
+		{"wcons", FindOptions{}, true},
+		{"WORK", FindOptions{}, true},
+		{"WORK", FindOptions{CaseSensitive: true}, false},
+		{"amazon", FindOptions{}, true},
+		{"amzn", FindOptions{}, false},
+		{"cloud", FindOptions{}, true},
+		{"^work/", FindOptions{Regex: true}, true},
+		{"^work/", FindOptions{Regex: true, CaseSensitive: true}, false},
+		{"^ops$", FindOptions{Regex: true}, true},
+		{"^console$", FindOptions{Regex: true}, false},
+	}
+	for _, tt := range tests {
+		match, err := NewMatcher(tt.query, tt.opts)
+		if err != nil {
+			t.Fatalf("Could not create matcher for %q: %s", tt.query, err)
+		}
+		if _, ok := match(site); ok != tt.matches {
+			t.Errorf("%q %+v matched: %t, expected %t", tt.query, tt.opts, ok, tt.matches)
+		}
+	}
+	if _, err := NewMatcher("(", FindOptions{Regex: true}); err == nil {
+		t.Errorf("Invalid regular expression did not return an error")
+	}
+}
@@ -1,17 +1,25 @@
 package show
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
-	"path/filepath"
 	"runtime"
 	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
 
+	"github.com/ejcx/passgo/v2/format"
 	"github.com/ejcx/passgo/v2/pc"
 	"github.com/ejcx/passgo/v2/pio"
+	"github.com/ejcx/passgo/v2/stats"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 type searchType int
@@ -29,11 +37,15 @@ const (
 	// One indicates SearchSites should return only one site from the vault.
 	// It is used when printing a site.
 	One
-	// Search indicates that SearchSites should return all sites found that
-	// match that contain the searchFor string
-	Search
+	// Group indicates that SearchSites should return all sites in the
+	// group searchFor and its subgroups.
+	Group
 )
 
+// binarySniffLen is how much of an entry is checked for binary data
+// before it is printed.
+const binarySniffLen = 8192
+
 func init() {
 	/* Windows doesn't work with ambiguous width characters */
 	if runtime.GOOS == "windows" {
@@ -55,108 +67,210 @@ func handleErrors(allErrors []error) {
 	}
 }
 
-// Find will search the vault for all occurences of frag in the site name.
-func Find(frag string) {
-	allSites, allErrors := SearchAll(Search, frag)
-	showResults(allSites)
+// Site will print out the password of the site that matches path. With a
+// format other than format.Plain the site is printed as a format.Secret.
+func Site(path string, copyPassword bool, f format.Format) {
+	allSites, allErrors := SearchAll(One, path)
+	if len(allSites) == 0 {
+		fmt.Printf("Site with path %s not found", path)
+		return
+	}
+	masterPrivKey := pc.GetMasterKey()
+	if f != format.Plain && !copyPassword {
+		showSecret(allSites, masterPrivKey, f)
+	} else {
+		showPassword(allSites, masterPrivKey, copyPassword)
+	}
 	handleErrors(allErrors)
 }
 
-// Site will print out the password of the site that matches path
-func Site(path string, copyPassword bool) {
+// Info will print out everything but the password of the site that
+// matches path.
+func Info(path string, f format.Format) {
 	allSites, allErrors := SearchAll(One, path)
 	if len(allSites) == 0 {
-		fmt.Printf("Site with path %s not found", path)
+		log.Fatalf("Site with path %s not found", path)
+	}
+	site := format.NewSite(fullSites(allSites)[0])
+	if f != format.Plain {
+		if err := f.Write(os.Stdout, site); err != nil {
+			log.Fatalf("Could not print %s: %s", path, err.Error())
+		}
 		return
 	}
-	masterPrivKey := pc.GetMasterKey()
-	showPassword(allSites, masterPrivKey, copyPassword)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", site.Name)
+	if site.Group != "" {
+		fmt.Fprintf(w, "Group:\t%s\n", site.Group)
+	}
+	fmt.Fprintf(w, "Type:\t%s\n", site.Type)
+	if site.URL != "" {
+		fmt.Fprintf(w, "URL:\t%s\n", site.URL)
+	}
+	if site.Username != "" {
+		fmt.Fprintf(w, "Username:\t%s\n", site.Username)
+	}
+	if len(site.Tags) > 0 {
+		fmt.Fprintf(w, "Tags:\t%s\n", strings.Join(site.Tags, ", "))
+	}
+	if site.SSHPublicKey != "" {
+		fmt.Fprintf(w, "Public key:\t%s\n", site.SSHPublicKey)
+	}
+	if site.Created != nil {
+		fmt.Fprintf(w, "Created:\t%s\n", site.Created.Local().Format(time.RFC1123))
+	}
+	if site.Modified != nil {
+		fmt.Fprintf(w, "Modified:\t%s\n", site.Modified.Local().Format(time.RFC1123))
+	}
+	if site.File != nil {
+		fmt.Fprintf(w, "File name:\t%s\n", site.File.Name)
+		fmt.Fprintf(w, "Mode:\t%s\n", site.File.Mode)
+		fmt.Fprintf(w, "Size:\t%s\n", stats.FormatSize(site.File.Size))
+		fmt.Fprintf(w, "Stored size:\t%s\n", stats.FormatSize(site.File.StoredSize))
+		if site.File.Compression != "" {
+			fmt.Fprintf(w, "Compression:\t%s\n", site.File.Compression)
+		}
+	}
+	w.Flush()
 	handleErrors(allErrors)
 }
 
 // ListAll will print out all contents of the vault.
-func ListAll() {
+func ListAll(order SortOrder, f format.Format) {
 	allSites, allErrors := SearchAll(All, "")
-	showResults(allSites)
+	showResults(allSites, order, f, nil)
+	handleErrors(allErrors)
+}
+
+// List will print out the contents of group and all of its subgroups.
+func List(group string, order SortOrder, f format.Format) {
+	group = pio.CleanPath(group)
+	if group == "" {
+		ListAll(order, f)
+		return
+	}
+	allSites, allErrors := SearchAll(Group, group)
+	if len(allSites) == 0 {
+		log.Fatalf("No sites found in %s", group)
+	}
+	if f != format.Plain {
+		showResults(allSites, order, f, nil)
+	} else {
+		printTree(os.Stdout, group, allSites, group, order, nil)
+	}
 	handleErrors(allErrors)
 }
 
 func showPassword(allSites map[string][]pio.SiteInfo, masterPrivKey [32]byte, copyPassword bool) {
 	for _, siteList := range allSites {
 		for _, site := range siteList {
-			var unsealed []byte
-			var err error
-			if site.IsFile {
-				fileDir, err := pio.GetEncryptedFilesDir()
-				if err != nil {
-					log.Fatalf("Could not get encrypted file dir when searching vault: %s", err.Error())
-				}
-				filePath := filepath.Join(fileDir, site.FileName)
-				f, err := os.OpenFile(filePath, os.O_RDONLY, 0600)
-				if err != nil {
-					log.Fatalf("Could not open encrypted file: %s", err.Error())
-				}
-				defer f.Close()
-
-				fileSealed, err := ioutil.ReadAll(f)
-				if err != nil {
-					log.Fatalf("Could not read encrypted file: %s", err.Error())
+			r, err := pc.OpenSite(&site, &masterPrivKey)
+			if err != nil {
+				if site.IsFile {
+					log.Fatalf("Could not decrypt file bytes: %s", err.Error())
 				}
-				unsealed, err = pc.OpenAsym(fileSealed, &site.PubKey, &masterPrivKey)
+				log.Println("Could not decrypt site password.")
+				continue
+			}
+			if copyPassword {
+				unsealed, err := ioutil.ReadAll(r)
+				r.Close()
 				if err != nil {
 					log.Fatalf("Could not decrypt file bytes: %s", err.Error())
 				}
-
-			} else {
-				unsealed, err = pc.OpenAsym(site.PassSealed, &site.PubKey, &masterPrivKey)
-				if err != nil {
-					log.Println("Could not decrypt site password.")
-					continue
+				if isBinary(unsealed, false) {
+					log.Fatalf("Refusing to copy binary file %s to the clipboard. Use --output to write it to a file", site.Name)
 				}
-			}
-			if copyPassword {
 				pio.ToClipboard(string(unsealed))
-			} else {
-				fmt.Println(string(unsealed))
+				continue
 			}
+			// Large files are streamed to stdout, so only the start of
+			// the file is checked for binary data.
+			br := bufio.NewReaderSize(r, binarySniffLen)
+			head, err := br.Peek(binarySniffLen)
+			if err != nil && err != io.EOF {
+				log.Fatalf("Could not decrypt file bytes: %s", err.Error())
+			}
+			if isBinary(head, err == nil) && terminal.IsTerminal(int(os.Stdout.Fd())) {
+				log.Fatalf("Refusing to print binary file %s to the terminal. Use --output to write it to a file", site.Name)
+			}
+			_, err = io.Copy(os.Stdout, br)
+			r.Close()
+			if err != nil {
+				log.Fatalf("Could not decrypt file bytes: %s", err.Error())
+			}
+			fmt.Println()
 		}
 	}
 }
 
-func showResults(allSites map[string][]pio.SiteInfo) {
-	fmt.Println(".")
-	counter := 1
-	for group, siteList := range allSites {
-		siteCounter := 1
-		for _, site := range siteList {
-			preGroup := regPrefix
-			preName := innerPrefix + regPrefix
-			if counter == len(allSites) {
-				preGroup = lastPrefix
-				sitePrefix := innerLastPrefix
-				if group == "" {
-					sitePrefix = ""
-				}
-				preName = sitePrefix + regPrefix
-				if siteCounter == len(siteList) {
-					preName = sitePrefix + lastPrefix
-				}
-			} else {
-				if siteCounter == len(siteList) {
-					preName = innerPrefix + lastPrefix
+// showSecret prints the site found by SearchAll along with its decrypted
+// contents as a format.Secret.
+func showSecret(allSites map[string][]pio.SiteInfo, masterPrivKey [32]byte, f format.Format) {
+	site := fullSites(allSites)[0]
+	r, err := pc.OpenSite(&site, &masterPrivKey)
+	if err != nil {
+		log.Fatalf("Could not decrypt %s: %s", site.Name, err.Error())
+	}
+	contents, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		log.Fatalf("Could not decrypt %s: %s", site.Name, err.Error())
+	}
+	if err := f.Write(os.Stdout, format.NewSecret(site, contents)); err != nil {
+		log.Fatalf("Could not print %s: %s", site.Name, err.Error())
+	}
+}
+
+// isBinary reports whether b looks like binary data rather than text. If
+// partial is set b may end in the middle of a UTF-8 sequence.
+func isBinary(b []byte, partial bool) bool {
+	if bytes.IndexByte(b, 0) >= 0 {
+		return true
+	}
+	if partial {
+		// Drop a trailing sequence that has been cut short.
+		n := len(b)
+		for i := 1; i < utf8.UTFMax && i <= n; i++ {
+			if utf8.RuneStart(b[n-i]) {
+				if !utf8.FullRune(b[n-i:]) {
+					b = b[:n-i]
 				}
+				break
 			}
+		}
+	}
+	return !utf8.Valid(b)
+}
 
-			if siteCounter == 1 {
-				if group != "" {
-					fmt.Println(preGroup + group)
-				}
+func showResults(allSites map[string][]pio.SiteInfo, order SortOrder, f format.Format, scores map[string]int) {
+	if f == format.Plain {
+		printTree(os.Stdout, ".", allSites, "", order, scores)
+		return
+	}
+	sites := fullSites(allSites)
+	sortSites(sites, order, scores)
+	records := []format.Site{}
+	for _, site := range sites {
+		records = append(records, format.NewSite(site))
+	}
+	if err := f.Write(os.Stdout, records); err != nil {
+		log.Fatalf("Could not print sites: %s", err.Error())
+	}
+}
+
+// fullSites returns the sites in allSites with their group added back to
+// their name.
+func fullSites(allSites map[string][]pio.SiteInfo) (sites []pio.SiteInfo) {
+	for group, siteList := range allSites {
+		for _, site := range siteList {
+			if group != "" {
+				site.Name = group + "/" + site.Name
 			}
-			fmt.Printf("%s%s\n", preName, site.Name)
-			siteCounter++
+			sites = append(sites, site)
 		}
-		counter++
 	}
+	return
 }
 
 // SearchAll will perform a search of searchType with optionally used searchFor. It
@@ -184,41 +298,34 @@ func SearchAll(st searchType, searchFor string) (allSites map[string][]pio.SiteI
 	}
 
 	for _, s := range sites {
-		slashIndex := strings.Index(string(s.Name), "/")
+		slashIndex := strings.LastIndex(string(s.Name), "/")
 		group := ""
 		if slashIndex > 0 {
 			group = string(s.Name[:slashIndex])
 		}
 		name := s.Name[slashIndex+1:]
-		pass := s.PassSealed
-		pubKey := s.PubKey
-		isFile := s.IsFile
-		filename := s.FileName
-		si := pio.SiteInfo{
-			Name:       name,
-			PassSealed: pass,
-			PubKey:     pubKey,
-			IsFile:     isFile,
-			FileName:   filename,
-		}
+		si := s
+		si.Name = name
 		if st == One {
-			if name == searchFor || fmt.Sprintf("%s/%s", group, name) == searchFor {
+			if s.Name == searchFor {
 				return map[string][]pio.SiteInfo{
 					group: []pio.SiteInfo{
 						si,
 					},
 				}, allErrors
 			}
+			// A bare site name also matches, unless a site with
+			// that exact path turns up later.
+			if name == searchFor && len(allSites) == 0 {
+				allSites[group] = []pio.SiteInfo{si}
+			}
 		} else if st == All {
 			if allSites[group] == nil {
 				allSites[group] = []pio.SiteInfo{}
 			}
 			allSites[group] = append(allSites[group], si)
-		} else if st == Search {
-			if strings.Contains(group, searchFor) || strings.Contains(name, searchFor) {
-				if allSites[group] == nil {
-					allSites[group] = []pio.SiteInfo{}
-				}
+		} else if st == Group {
+			if pio.InGroup(s.Name, searchFor) {
 				allSites[group] = append(allSites[group], si)
 			}
 		}
@@ -0,0 +1,193 @@
+package show
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// SortOrder is the order that sites and groups are listed in.
+type SortOrder int
+
+const (
+	// SortName lists sites and groups alphabetically.
+	SortName SortOrder = iota
+	// SortModified lists the most recently changed sites and groups first.
+	SortModified
+	// SortCreated lists the most recently added sites and groups first.
+	SortCreated
+	// SortScore lists the best matches of a search first. Anything that
+	// is not a search is sorted by name.
+	SortScore
+)
+
+var sortOrders = map[string]SortOrder{
+	"name":     SortName,
+	"modified": SortModified,
+	"created":  SortCreated,
+	"score":    SortScore,
+}
+
+// ParseSortOrder returns the SortOrder called name, which is one of name,
+// modified, created or score.
+func ParseSortOrder(name string) (SortOrder, error) {
+	order, ok := sortOrders[name]
+	if !ok {
+		return SortName, fmt.Errorf("Unknown sort order %q, must be name, modified, created or score", name)
+	}
+	return order, nil
+}
+
+// tree is a group or site in a listing of the vault. A site may also be
+// a group when other sites are nested below it. The times are those of
+// the newest site in the tree, and the score that of its best match.
+type tree struct {
+	name     string
+	created  time.Time
+	modified time.Time
+	score    int
+	scored   bool
+	site     bool
+	children []*tree
+}
+
+// TreeLine is a line of the tree of sites printed by passgo.
+type TreeLine struct {
+	// Prefix is the box drawing in front of the name.
+	Prefix string
+	Name   string
+	// Path is the full path of the site or group.
+	Path string
+	// Site is set when Path is a site, rather than just a group.
+	Site bool
+}
+
+// printTree prints allSites below the group root as a tree, like tree(1)
+// does, under the heading label. scores holds how well each site, by full
+// name, matched a search, and may be nil.
+func printTree(w io.Writer, label string, allSites map[string][]pio.SiteInfo, root string, order SortOrder, scores map[string]int) {
+	fmt.Fprintln(w, label)
+	for _, l := range newTree(allSites, root, order, scores).lines(nil, "", root) {
+		fmt.Fprintf(w, "%s%s\n", l.Prefix, l.Name)
+	}
+}
+
+// TreeLines returns the lines of the tree of allSites, as it is printed
+// when listing the vault, without the heading.
+func TreeLines(allSites map[string][]pio.SiteInfo, order SortOrder, scores map[string]int) []TreeLine {
+	return newTree(allSites, "", order, scores).lines(nil, "", "")
+}
+
+func newTree(allSites map[string][]pio.SiteInfo, root string, order SortOrder, scores map[string]int) *tree {
+	t := &tree{}
+	for group, siteList := range allSites {
+		for _, site := range siteList {
+			path := site.Name
+			if group != "" {
+				path = group + "/" + site.Name
+			}
+			score, scored := scores[path]
+			if root != "" {
+				path = strings.TrimPrefix(path, root+"/")
+			}
+			t.add(strings.Split(path, "/"), site, score, scored)
+		}
+	}
+	t.sort(order)
+	return t
+}
+
+func (t *tree) add(path []string, site pio.SiteInfo, score int, scored bool) {
+	if scored && (!t.scored || score > t.score) {
+		t.score, t.scored = score, true
+	}
+	if site.Created != nil && site.Created.After(t.created) {
+		t.created = *site.Created
+	}
+	if site.Modified != nil && site.Modified.After(t.modified) {
+		t.modified = *site.Modified
+	}
+	if len(path) == 0 {
+		t.site = true
+		return
+	}
+	for _, c := range t.children {
+		if c.name == path[0] {
+			c.add(path[1:], site, score, scored)
+			return
+		}
+	}
+	c := &tree{name: path[0]}
+	t.children = append(t.children, c)
+	c.add(path[1:], site, score, scored)
+}
+
+// sort orders the children of t and all of their children. Ties are
+// broken by name so that the order never depends on the vault.
+func (t *tree) sort(order SortOrder) {
+	sort.Slice(t.children, func(i, j int) bool {
+		a, b := t.children[i], t.children[j]
+		switch {
+		case order == SortModified && !a.modified.Equal(b.modified):
+			return a.modified.After(b.modified)
+		case order == SortCreated && !a.created.Equal(b.created):
+			return a.created.After(b.created)
+		case order == SortScore && a.score != b.score:
+			return a.score > b.score
+		}
+		return a.name < b.name
+	})
+	for _, c := range t.children {
+		c.sort(order)
+	}
+}
+
+// sortSites sorts sites by their full name, with the most recently
+// modified or created first, or with the best scores first.
+func sortSites(sites []pio.SiteInfo, order SortOrder, scores map[string]int) {
+	timeOf := func(t *time.Time) time.Time {
+		if t == nil {
+			return time.Time{}
+		}
+		return *t
+	}
+	sort.Slice(sites, func(i, j int) bool {
+		a, b := sites[i], sites[j]
+		var at, bt time.Time
+		switch order {
+		case SortModified:
+			at, bt = timeOf(a.Modified), timeOf(b.Modified)
+		case SortCreated:
+			at, bt = timeOf(a.Created), timeOf(b.Created)
+		}
+		if !at.Equal(bt) {
+			return at.After(bt)
+		}
+		if order == SortScore && scores[a.Name] != scores[b.Name] {
+			return scores[a.Name] > scores[b.Name]
+		}
+		return a.Name < b.Name
+	})
+}
+
+// lines appends the lines of the children of t, which is the group
+// path, to ls with every prefix starting with indent.
+func (t *tree) lines(ls []TreeLine, indent, path string) []TreeLine {
+	for i, c := range t.children {
+		prefix, inner := regPrefix, innerPrefix
+		if i == len(t.children)-1 {
+			prefix, inner = lastPrefix, innerLastPrefix
+		}
+		p := c.name
+		if path != "" {
+			p = path + "/" + c.name
+		}
+		ls = append(ls, TreeLine{Prefix: indent + prefix, Name: c.name, Path: p, Site: c.site})
+		ls = c.lines(ls, indent+inner, p)
+	}
+	return ls
+}
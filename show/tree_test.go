@@ -0,0 +1,122 @@
+package show
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// testSites returns a vault with nested groups, a site that is also a
+// group and sites without timestamps, grouped like SearchAll does.
+func testSites() map[string][]pio.SiteInfo {
+	at := func(day int) *time.Time {
+		t := time.Date(2019, 3, day, 12, 0, 0, 0, time.UTC)
+		return &t
+	}
+	sites := []struct {
+		group, name       string
+		created, modified *time.Time
+	}{
+		{"work/aws", "prod", at(1), at(20)},
+		{"work/aws", "stage", at(2), at(3)},
+		{"work/gcp", "prod", at(10), at(10)},
+		{"work", "gcp", at(11), at(11)},
+		{"money", "bank.com", at(5), at(6)},
+		{"money", "budget.csv", at(4), at(25)},
+		{"", "wifi", nil, nil},
+		{"", "alarm", at(15), at(15)},
+	}
+	allSites := map[string][]pio.SiteInfo{}
+	for _, s := range sites {
+		allSites[s.group] = append(allSites[s.group], pio.SiteInfo{
+			Name:     s.name,
+			Created:  s.created,
+			Modified: s.modified,
+		})
+	}
+	return allSites
+}
+
+func TestPrintTree(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The golden files use the box drawing characters")
+	}
+	tests := []struct {
+		golden string
+		root   string
+		order  SortOrder
+	}{
+		{"tree-name.golden", "", SortName},
+		{"tree-modified.golden", "", SortModified},
+		{"tree-created.golden", "", SortCreated},
+		{"tree-group.golden", "work", SortName},
+	}
+	for _, tt := range tests {
+		allSites := testSites()
+		if tt.root != "" {
+			for group := range allSites {
+				if !pio.InGroup(group+"/", tt.root) {
+					delete(allSites, group)
+				}
+			}
+		}
+		label := tt.root
+		if label == "" {
+			label = "."
+		}
+		var out bytes.Buffer
+		printTree(&out, label, allSites, tt.root, tt.order, nil)
+
+		golden := filepath.Join("testdata", tt.golden)
+		if *update {
+			if err := ioutil.WriteFile(golden, out.Bytes(), 0644); err != nil {
+				t.Fatalf("Could not update %s: %s", golden, err)
+			}
+		}
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatalf("Could not read %s: %s", golden, err)
+		}
+		if !bytes.Equal(out.Bytes(), expected) {
+			t.Errorf("%s does not match:\n%s\nexpected:\n%s", tt.golden, out.Bytes(), expected)
+		}
+	}
+}
+
+func TestPrintTreeIsStable(t *testing.T) {
+	var first bytes.Buffer
+	printTree(&first, ".", testSites(), "", SortModified, nil)
+	for i := 0; i < 20; i++ {
+		allSites := testSites()
+		for _, siteList := range allSites {
+			rand.Shuffle(len(siteList), func(i, j int) {
+				siteList[i], siteList[j] = siteList[j], siteList[i]
+			})
+		}
+		var out bytes.Buffer
+		printTree(&out, ".", allSites, "", SortModified, nil)
+		if !bytes.Equal(out.Bytes(), first.Bytes()) {
+			t.Fatalf("Output depends on the order of the vault:\n%s\nand\n%s", first.Bytes(), out.Bytes())
+		}
+	}
+}
+
+func TestParseSortOrder(t *testing.T) {
+	for name, expected := range sortOrders {
+		if order, err := ParseSortOrder(name); err != nil || order != expected {
+			t.Errorf("ParseSortOrder(%q) = %v, %v", name, order, err)
+		}
+	}
+	if _, err := ParseSortOrder("size"); err == nil {
+		t.Errorf("ParseSortOrder accepted an unknown order")
+	}
+}
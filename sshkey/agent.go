@@ -0,0 +1,243 @@
+package sshkey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+var (
+	errReadOnly = errors.New("Keys are added and removed with passgo ssh-key")
+	errLocked   = errors.New("The agent is locked")
+)
+
+// Agent is an ssh-agent for the SSH key entries in the vault. Keys are
+// only decrypted while signing.
+type Agent struct {
+	// Confirm, if set, is asked before every signature with the name of
+	// the key.
+	Confirm func(name string) bool
+
+	mu            sync.Mutex
+	masterPrivKey [32]byte
+	// lock is the hash of the passphrase the agent was locked with.
+	lock []byte
+}
+
+// NewAgent returns an agent for the vault unlocked with masterPrivKey.
+func NewAgent(masterPrivKey [32]byte) *Agent {
+	return &Agent{masterPrivKey: masterPrivKey}
+}
+
+// DefaultSocket returns where the agent listens when it is not told:
+// passgo-agent.sock in $XDG_RUNTIME_DIR.
+func DefaultSocket() string {
+	dir := os.Getenv("XDG_RUNTIME_DIR")
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "passgo-agent.sock")
+}
+
+// Serve unlocks the vault and runs an agent on the Unix socket at socket
+// until passgo is interrupted. With confirm set, every signature has to
+// be approved on the terminal.
+func Serve(socket string, confirm bool) error {
+	a := NewAgent(pc.GetMasterKey())
+	if confirm {
+		a.Confirm = func(name string) bool {
+			ok, err := pio.Confirm(fmt.Sprintf("Allow signing with %s?", name))
+			return err == nil && ok
+		}
+	}
+	if fi, err := os.Lstat(socket); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		if c, err := net.Dial("unix", socket); err == nil {
+			c.Close()
+			return fmt.Errorf("An agent is already running on %s", socket)
+		}
+		os.Remove(socket)
+	}
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	if err = os.Chmod(socket, 0600); err != nil {
+		return err
+	}
+	fmt.Printf("SSH_AUTH_SOCK=%s; export SSH_AUTH_SOCK;\n", socket)
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-stop
+		l.Close()
+	}()
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			// The listener was closed.
+			return nil
+		}
+		go func() {
+			agent.ServeAgent(a, c)
+			c.Close()
+		}()
+	}
+}
+
+// List returns the public keys of every SSH key entry.
+func (a *Agent) List() ([]*agent.Key, error) {
+	a.mu.Lock()
+	locked := a.lock != nil
+	a.mu.Unlock()
+	keys := []*agent.Key{}
+	if locked {
+		return keys, nil
+	}
+	for _, site := range pio.GetVault() {
+		if site.SSHPublicKey == "" {
+			continue
+		}
+		pub, err := parsePublicKey(site.SSHPublicKey)
+		if err != nil {
+			log.Printf("Could not list %s: %s", site.Name, err.Error())
+			continue
+		}
+		keys = append(keys, &agent.Key{Format: pub.Type(), Blob: pub.Marshal(), Comment: site.Name})
+	}
+	return keys, nil
+}
+
+// Sign signs data with the private key of key.
+func (a *Agent) Sign(key ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	return a.SignWithFlags(key, data, 0)
+}
+
+// SignWithFlags signs data with the private key of key, using SHA-2 for
+// RSA keys when flags asks for it.
+func (a *Agent) SignWithFlags(key ssh.PublicKey, data []byte, flags agent.SignatureFlags) (*ssh.Signature, error) {
+	site, err := a.find(key)
+	if err != nil {
+		return nil, err
+	}
+	a.mu.Lock()
+	locked := a.lock != nil
+	masterPrivKey := a.masterPrivKey
+	a.mu.Unlock()
+	if locked {
+		return nil, errLocked
+	}
+	if a.Confirm != nil && !a.Confirm(site.Name) {
+		return nil, fmt.Errorf("Signing with %s was not approved", site.Name)
+	}
+
+	pemBytes, err := pc.OpenAsym(site.PassSealed, &site.PubKey, &masterPrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("Could not decrypt %s: %s", site.Name, err.Error())
+	}
+	defer func() {
+		for i := range pemBytes {
+			pemBytes[i] = 0
+		}
+	}()
+	priv, err := ssh.ParseRawPrivateKey(pemBytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := ssh.NewSignerFromKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	if algSigner, ok := signer.(ssh.AlgorithmSigner); ok && key.Type() == ssh.KeyAlgoRSA {
+		switch {
+		case flags&agent.SignatureFlagRsaSha256 != 0:
+			return algSigner.SignWithAlgorithm(rand.Reader, data, ssh.SigAlgoRSASHA2256)
+		case flags&agent.SignatureFlagRsaSha512 != 0:
+			return algSigner.SignWithAlgorithm(rand.Reader, data, ssh.SigAlgoRSASHA2512)
+		}
+	}
+	return signer.Sign(rand.Reader, data)
+}
+
+// find returns the SSH key entry for key.
+func (a *Agent) find(key ssh.PublicKey) (pio.SiteInfo, error) {
+	want := key.Marshal()
+	for _, site := range pio.GetVault() {
+		if site.SSHPublicKey == "" {
+			continue
+		}
+		if pub, err := parsePublicKey(site.SSHPublicKey); err == nil && bytes.Equal(pub.Marshal(), want) {
+			return site, nil
+		}
+	}
+	return pio.SiteInfo{}, errors.New("No such key in the vault")
+}
+
+// Lock stops the agent from listing keys or signing until it is unlocked
+// with the same passphrase.
+func (a *Agent) Lock(passphrase []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.lock != nil {
+		return errLocked
+	}
+	hash := sha256.Sum256(passphrase)
+	a.lock = hash[:]
+	return nil
+}
+
+// Unlock undoes Lock.
+func (a *Agent) Unlock(passphrase []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	hash := sha256.Sum256(passphrase)
+	if a.lock == nil || subtle.ConstantTimeCompare(hash[:], a.lock) != 1 {
+		return errors.New("Wrong passphrase")
+	}
+	a.lock = nil
+	return nil
+}
+
+// Add is not supported, since keys come from the vault.
+func (a *Agent) Add(key agent.AddedKey) error {
+	return errReadOnly
+}
+
+// Remove is not supported, since keys come from the vault.
+func (a *Agent) Remove(key ssh.PublicKey) error {
+	return errReadOnly
+}
+
+// RemoveAll is not supported, since keys come from the vault.
+func (a *Agent) RemoveAll() error {
+	return errReadOnly
+}
+
+// Signers is not supported, since keys are only decrypted while signing.
+func (a *Agent) Signers() ([]ssh.Signer, error) {
+	return nil, errors.New("Signers are not available from passgo")
+}
+
+// Extension is not supported.
+func (a *Agent) Extension(extensionType string, contents []byte) ([]byte, error) {
+	return nil, agent.ErrExtensionUnsupported
+}
@@ -0,0 +1,126 @@
+// Package sshkey stores SSH private keys in the vault, and serves them to
+// ssh with the ssh-agent protocol.
+package sshkey
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ejcx/passgo/v2/insert"
+	"github.com/ejcx/passgo/v2/pio"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh"
+)
+
+// Import adds the unencrypted private key in the file path to the vault
+// as name, and returns its public key in authorized_keys format.
+func Import(name, path string, meta pio.Metadata) (string, error) {
+	pemBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	key, err := ssh.ParseRawPrivateKey(pemBytes)
+	if err != nil {
+		if strings.Contains(err.Error(), "encrypted") {
+			return "", fmt.Errorf("%s is protected by a passphrase. Remove it from a copy with ssh-keygen -p and import that", path)
+		}
+		return "", err
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return "", err
+	}
+	pub := authorizedKey(signer.PublicKey(), name)
+	if err = insert.SealSSHKey(name, pemBytes, pub, meta); err != nil {
+		return "", err
+	}
+	return pub, nil
+}
+
+// Generate adds a new ed25519 key to the vault as name, and returns its
+// public key in authorized_keys format.
+func Generate(name string, meta pio.Metadata) (string, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+	authorized := authorizedKey(sshPub, name)
+	if err = insert.SealSSHKey(name, marshalED25519(priv, name), authorized, meta); err != nil {
+		return "", err
+	}
+	return authorized, nil
+}
+
+// PublicKey returns the public key of the SSH key entry called name,
+// without unlocking the vault.
+func PublicKey(name string) (string, error) {
+	for _, site := range pio.GetVault() {
+		if site.Name == name {
+			if site.SSHPublicKey == "" {
+				return "", fmt.Errorf("%s is not an SSH key", name)
+			}
+			return site.SSHPublicKey, nil
+		}
+	}
+	return "", fmt.Errorf("Site %s not found", name)
+}
+
+// authorizedKey returns pub in authorized_keys format, with the name of
+// its site as the comment.
+func authorizedKey(pub ssh.PublicKey, name string) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub))) + " " + name
+}
+
+// marshalED25519 encodes priv in the unencrypted OpenSSH private key format,
+// described in PROTOCOL.key in the OpenSSH sources.
+func marshalED25519(priv ed25519.PrivateKey, comment string) []byte {
+	pub := priv.Public().(ed25519.PublicKey)
+	pubKey := ssh.Marshal(struct {
+		KeyType string
+		Pub     []byte
+	}{ssh.KeyAlgoED25519, pub})
+
+	var check [4]byte
+	rand.Read(check[:])
+	checkInt := binary.BigEndian.Uint32(check[:])
+	privBlock := ssh.Marshal(struct {
+		Check1  uint32
+		Check2  uint32
+		KeyType string
+		Pub     []byte
+		Priv    []byte
+		Comment string
+	}{checkInt, checkInt, ssh.KeyAlgoED25519, pub, priv, comment})
+	// The block is padded to the cipher block size, 8 for "none".
+	for i := 1; len(privBlock)%8 != 0; i++ {
+		privBlock = append(privBlock, byte(i))
+	}
+
+	key := append([]byte("openssh-key-v1\x00"), ssh.Marshal(struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{"none", "none", "", 1, pubKey, privBlock})...)
+	return pem.EncodeToMemory(&pem.Block{Type: "OPENSSH PRIVATE KEY", Bytes: key})
+}
+
+// parsePublicKey parses a public key in authorized_keys format.
+func parsePublicKey(authorized string) (ssh.PublicKey, error) {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(authorized))
+	if err != nil {
+		return nil, errors.New("Could not parse SSH public key")
+	}
+	return pub, nil
+}
@@ -0,0 +1,150 @@
+package sshkey
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/internal/vaulttest"
+	"github.com/ejcx/passgo/v2/pio"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// newVault creates a vault holding a generated ed25519 key and an
+// imported RSA key, and returns their public keys.
+func newVault(t *testing.T) (edPub, rsaPub ssh.PublicKey, cleanup func()) {
+	dir, cleanup := vaulttest.New(t)
+
+	authorized, err := Generate("ssh/github", pio.Metadata{})
+	if err != nil {
+		t.Fatalf("Could not generate key: %s", err)
+	}
+	if !strings.HasPrefix(authorized, "ssh-ed25519 ") || !strings.HasSuffix(authorized, " ssh/github") {
+		t.Fatalf("Generated public key is %q", authorized)
+	}
+	edPub, _ = parsePublicKey(authorized)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Could not generate RSA key: %s", err)
+	}
+	keyFile := filepath.Join(dir, "id_rsa")
+	ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}), 0600)
+	authorized, err = Import("ssh/work", keyFile, pio.Metadata{})
+	if err != nil {
+		t.Fatalf("Could not import key: %s", err)
+	}
+	rsaPub, _ = parsePublicKey(authorized)
+	return edPub, rsaPub, cleanup
+}
+
+// newClient serves a over a pipe, and returns a client for it.
+func newClient(a *Agent) agent.ExtendedAgent {
+	c1, c2 := net.Pipe()
+	go agent.ServeAgent(a, c2)
+	return agent.NewClient(c1)
+}
+
+func TestMarshalED25519(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(rand.Reader)
+	parsed, err := ssh.ParseRawPrivateKey(marshalED25519(priv, "comment"))
+	if err != nil {
+		t.Fatalf("Could not parse marshalled key: %s", err)
+	}
+	if got := *parsed.(*ed25519.PrivateKey); string(got) != string(priv) {
+		t.Fatalf("Parsed key does not match")
+	}
+}
+
+func TestImport(t *testing.T) {
+	_, _, cleanup := newVault(t)
+	defer cleanup()
+
+	if pub, err := PublicKey("ssh/work"); err != nil || !strings.HasPrefix(pub, "ssh-rsa ") {
+		t.Errorf("PublicKey returned %q, %v", pub, err)
+	}
+	if _, err := PublicKey("ssh/missing"); err == nil {
+		t.Errorf("PublicKey of a missing site did not fail")
+	}
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 1024)
+	block, _ := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey), []byte("passphrase"), x509.PEMCipherAES256)
+	keyFile := filepath.Join(os.Getenv(pio.PASSGODIR), "id_encrypted")
+	ioutil.WriteFile(keyFile, pem.EncodeToMemory(block), 0600)
+	if _, err := Import("ssh/encrypted", keyFile, pio.Metadata{}); err == nil || !strings.Contains(err.Error(), "passphrase") {
+		t.Errorf("Importing an encrypted key returned %v", err)
+	}
+}
+
+func TestAgent(t *testing.T) {
+	edPub, rsaPub, cleanup := newVault(t)
+	defer cleanup()
+	a := NewAgent(vaulttest.Unlock())
+	denied := false
+	a.Confirm = func(name string) bool {
+		return !denied
+	}
+	client := newClient(a)
+
+	keys, err := client.List()
+	if err != nil || len(keys) != 2 || keys[0].Comment != "ssh/github" || keys[1].Comment != "ssh/work" {
+		t.Fatalf("List returned %v, %v", keys, err)
+	}
+
+	data := []byte("session data")
+	for _, pub := range []ssh.PublicKey{edPub, rsaPub} {
+		sig, err := client.Sign(pub, data)
+		if err != nil {
+			t.Fatalf("Could not sign with %s: %s", pub.Type(), err)
+		}
+		if err = pub.Verify(data, sig); err != nil {
+			t.Errorf("%s signature does not verify: %s", pub.Type(), err)
+		}
+	}
+	sig, err := client.SignWithFlags(rsaPub, data, agent.SignatureFlagRsaSha256)
+	if err != nil || sig.Format != ssh.SigAlgoRSASHA2256 || rsaPub.Verify(data, sig) != nil {
+		t.Errorf("rsa-sha2-256 signature %v, %v", sig, err)
+	}
+
+	otherPub, _, _ := ed25519.GenerateKey(rand.Reader)
+	other, _ := ssh.NewPublicKey(otherPub)
+	if _, err = client.Sign(other, data); err == nil {
+		t.Errorf("Signed with a key that is not in the vault")
+	}
+	denied = true
+	if _, err = client.Sign(edPub, data); err == nil {
+		t.Errorf("Signed without approval")
+	}
+	denied = false
+
+	if err = client.Add(agent.AddedKey{PrivateKey: otherPub}); err == nil {
+		t.Errorf("Added a key to the agent")
+	}
+	if err = client.Lock([]byte("lock")); err != nil {
+		t.Fatalf("Could not lock: %s", err)
+	}
+	if keys, _ = client.List(); len(keys) != 0 {
+		t.Errorf("Locked agent listed %d keys", len(keys))
+	}
+	if _, err = client.Sign(edPub, data); err == nil {
+		t.Errorf("Locked agent signed")
+	}
+	if err = client.Unlock([]byte("wrong")); err == nil {
+		t.Errorf("Unlocked with the wrong passphrase")
+	}
+	if err = client.Unlock([]byte("lock")); err != nil {
+		t.Fatalf("Could not unlock: %s", err)
+	}
+	if _, err = client.Sign(edPub, data); err != nil {
+		t.Errorf("Could not sign after unlocking: %s", err)
+	}
+}
@@ -0,0 +1,79 @@
+// Package stats reports how much space file entries take up in the vault.
+package stats
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+)
+
+// Stats prints the original and stored size of every file entry in the
+// vault, along with the totals.
+func Stats() {
+	encFileDir, err := pio.GetEncryptedFilesDir()
+	if err != nil {
+		log.Fatalf("Could not get encrypted file dir: %s", err.Error())
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tORIGINAL\tSTORED\tRATIO\tCOMPRESSION")
+	var files int
+	var totalOrig, totalStored int64
+	for _, site := range pio.GetVault() {
+		if !site.IsFile {
+			continue
+		}
+		fi, err := os.Stat(filepath.Join(encFileDir, site.FileName))
+		if err != nil {
+			log.Printf("Could not stat %s: %s", site.Name, err.Error())
+			continue
+		}
+		orig := Original(&site, fi.Size())
+		compression := site.Compression
+		if compression == pio.CompressionNone {
+			compression = "none"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", site.Name, FormatSize(orig), FormatSize(fi.Size()), ratio(fi.Size(), orig), compression)
+		files++
+		totalOrig += orig
+		totalStored += fi.Size()
+	}
+	fmt.Fprintf(w, "%d files\t%s\t%s\t%s\n", files, FormatSize(totalOrig), FormatSize(totalStored), ratio(totalStored, totalOrig))
+	w.Flush()
+}
+
+// Original returns the size of a file entry before it was compressed and
+// encrypted, given the size of its encrypted file.
+func Original(site *pio.SiteInfo, storedSize int64) int64 {
+	// Entries inserted before sizes were recorded are never compressed,
+	// so their size can be worked out from the encrypted size.
+	if site.Size != 0 || site.Compression != pio.CompressionNone {
+		return site.Size
+	}
+	return pc.PayloadSize(site, storedSize)
+}
+
+func ratio(stored, orig int64) string {
+	if orig == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", 100*float64(stored)/float64(orig))
+}
+
+// FormatSize formats a number of bytes for people to read.
+func FormatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,585 @@
+// Package tui is a full-screen terminal interface for browsing and
+// changing the vault.
+package tui
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/atotto/clipboard"
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/ejcx/passgo/v2/edit"
+	"github.com/ejcx/passgo/v2/insert"
+	"github.com/ejcx/passgo/v2/pc"
+	"github.com/ejcx/passgo/v2/pio"
+	"github.com/ejcx/passgo/v2/show"
+)
+
+const (
+	// help is shown at the bottom of the screen when there is no status.
+	help = "Enter reveal  ^Y copy  ^U copy user  ^A add  ^E edit  ^R rename  ^D delete  Esc quit"
+	// maxReveal is the largest file that is revealed on screen or copied.
+	maxReveal = 4096
+	mask      = "********"
+)
+
+var (
+	styleDefault  = tcell.StyleDefault
+	styleSelected = tcell.StyleDefault.Reverse(true)
+	styleGroup    = tcell.StyleDefault.Bold(true)
+	styleStatus   = tcell.StyleDefault.Dim(true)
+)
+
+// App is the state of the terminal interface.
+type App struct {
+	screen        tcell.Screen
+	masterPrivKey [32]byte
+	// copy is used to copy to the clipboard. It is replaced in tests.
+	copy func(string) error
+
+	filter   string
+	lines    []show.TreeLine
+	sites    map[string]pio.SiteInfo
+	selected int
+	top      int
+	revealed string
+	status   string
+	dialog   *dialog
+}
+
+// dialog asks for a line of input on top of the vault.
+type dialog struct {
+	prompt string
+	value  string
+	// secret hides what is typed.
+	secret bool
+	// confirm asks a yes or no question instead of reading a line.
+	confirm bool
+	done    func(value string)
+}
+
+// Start unlocks the vault and runs the interface until the user quits.
+func Start() error {
+	masterPrivKey := pc.GetMasterKey()
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return err
+	}
+	if err = screen.Init(); err != nil {
+		return err
+	}
+	defer screen.Fini()
+	return New(screen, masterPrivKey).Run()
+}
+
+// New returns the interface for an initialized screen, using the already
+// unlocked master private key.
+func New(screen tcell.Screen, masterPrivKey [32]byte) *App {
+	a := &App{
+		screen:        screen,
+		masterPrivKey: masterPrivKey,
+		copy:          clipboard.WriteAll,
+	}
+	a.reload()
+	return a
+}
+
+// Run handles events until the user quits.
+func (a *App) Run() error {
+	a.draw()
+	for {
+		ev := a.screen.PollEvent()
+		if ev == nil {
+			return nil
+		}
+		if !a.handle(ev) {
+			return nil
+		}
+		a.draw()
+	}
+}
+
+// reload reads the vault and applies the filter to it. The first site is
+// selected, which is the best match when filtering.
+func (a *App) reload() {
+	match, err := show.NewMatcher(a.filter, show.FindOptions{})
+	if err != nil {
+		a.status = err.Error()
+		return
+	}
+	allSites, _ := show.SearchAll(show.All, "")
+	filtered := map[string][]pio.SiteInfo{}
+	scores := map[string]int{}
+	a.sites = map[string]pio.SiteInfo{}
+	for group, siteList := range allSites {
+		for _, site := range siteList {
+			full := site
+			if group != "" {
+				full.Name = group + "/" + site.Name
+			}
+			score, ok := match(full)
+			if !ok {
+				continue
+			}
+			scores[full.Name] = score
+			a.sites[full.Name] = full
+			filtered[group] = append(filtered[group], site)
+		}
+	}
+	order := show.SortName
+	if a.filter != "" {
+		order = show.SortScore
+	}
+	a.lines = show.TreeLines(filtered, order, scores)
+
+	a.selected = 0
+	for i, line := range a.lines {
+		if line.Site {
+			a.selected = i
+			break
+		}
+	}
+	a.revealed = ""
+}
+
+// current returns the selected line.
+func (a *App) current() (show.TreeLine, bool) {
+	if a.selected < 0 || a.selected >= len(a.lines) {
+		return show.TreeLine{}, false
+	}
+	return a.lines[a.selected], true
+}
+
+// currentSite returns the selected site, or sets the status if a group is
+// selected.
+func (a *App) currentSite() (pio.SiteInfo, bool) {
+	line, ok := a.current()
+	if !ok || !line.Site {
+		a.status = "Select a site first"
+		return pio.SiteInfo{}, false
+	}
+	return a.sites[line.Path], true
+}
+
+// handle handles an event and reports whether the interface should keep
+// running.
+func (a *App) handle(ev tcell.Event) bool {
+	switch ev := ev.(type) {
+	case *tcell.EventResize:
+		a.screen.Sync()
+	case *tcell.EventKey:
+		a.status = ""
+		if a.dialog != nil {
+			a.handleDialog(ev)
+			return true
+		}
+		return a.handleKey(ev)
+	}
+	return true
+}
+
+func (a *App) handleKey(ev *tcell.EventKey) bool {
+	switch ev.Key() {
+	case tcell.KeyCtrlC:
+		return false
+	case tcell.KeyEscape:
+		if a.filter == "" {
+			return false
+		}
+		a.filter = ""
+		a.reload()
+	case tcell.KeyUp, tcell.KeyCtrlP:
+		a.move(-1)
+	case tcell.KeyDown, tcell.KeyCtrlN:
+		a.move(1)
+	case tcell.KeyPgUp:
+		a.move(-a.listHeight())
+	case tcell.KeyPgDn:
+		a.move(a.listHeight())
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if a.filter != "" {
+			_, size := utf8.DecodeLastRuneInString(a.filter)
+			a.filter = a.filter[:len(a.filter)-size]
+			a.reload()
+		}
+	case tcell.KeyEnter:
+		a.reveal()
+	case tcell.KeyCtrlY:
+		a.copySecret()
+	case tcell.KeyCtrlU:
+		if site, ok := a.currentSite(); ok {
+			a.copyField("username", site.Username)
+		}
+	case tcell.KeyCtrlA:
+		a.add()
+	case tcell.KeyCtrlE:
+		a.editSite()
+	case tcell.KeyCtrlR:
+		a.rename()
+	case tcell.KeyCtrlD:
+		a.remove()
+	case tcell.KeyRune:
+		a.filter += string(ev.Rune())
+		a.reload()
+	}
+	return true
+}
+
+func (a *App) move(n int) {
+	a.selected += n
+	if a.selected >= len(a.lines) {
+		a.selected = len(a.lines) - 1
+	}
+	if a.selected < 0 {
+		a.selected = 0
+	}
+	a.revealed = ""
+}
+
+// decrypt returns the contents of site. Only the first maxReveal+1 bytes
+// of a file are read, which is enough to tell that it is too large.
+func (a *App) decrypt(site pio.SiteInfo) ([]byte, error) {
+	r, err := pc.OpenSite(&site, &a.masterPrivKey)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	if site.IsFile {
+		return ioutil.ReadAll(io.LimitReader(r, maxReveal+1))
+	}
+	return ioutil.ReadAll(r)
+}
+
+func (a *App) reveal() {
+	site, ok := a.currentSite()
+	if !ok {
+		return
+	}
+	if a.revealed != "" {
+		a.revealed = ""
+		return
+	}
+	contents, err := a.decrypt(site)
+	if err != nil {
+		a.status = fmt.Sprintf("Could not decrypt %s: %s", site.Name, err.Error())
+		return
+	}
+	if site.IsFile && (len(contents) > maxReveal || !utf8.Valid(contents)) {
+		a.status = "File is too large or binary to show. Use passgo show --output"
+		return
+	}
+	a.revealed = string(contents)
+	if a.revealed == "" {
+		a.revealed = " "
+	}
+}
+
+func (a *App) copySecret() {
+	site, ok := a.currentSite()
+	if !ok {
+		return
+	}
+	contents, err := a.decrypt(site)
+	if err != nil {
+		a.status = fmt.Sprintf("Could not decrypt %s: %s", site.Name, err.Error())
+		return
+	}
+	if site.IsFile && len(contents) > maxReveal {
+		a.status = "File is too large to copy. Use passgo show --output"
+		return
+	}
+	if !utf8.Valid(contents) {
+		a.status = "Refusing to copy a binary file"
+		return
+	}
+	a.copyField("password", string(contents))
+}
+
+func (a *App) copyField(field, value string) {
+	if value == "" {
+		a.status = fmt.Sprintf("The site has no %s", field)
+		return
+	}
+	if err := a.copy(value); err != nil {
+		a.status = fmt.Sprintf("Could not copy %s: %s", field, err.Error())
+		return
+	}
+	a.status = fmt.Sprintf("Copied %s to the clipboard", field)
+}
+
+// defaultGroup returns the group of the selected line, for new sites.
+func (a *App) defaultGroup() string {
+	line, ok := a.current()
+	if !ok {
+		return ""
+	}
+	if !line.Site {
+		return line.Path + "/"
+	}
+	if i := strings.LastIndex(line.Path, "/"); i >= 0 {
+		return line.Path[:i+1]
+	}
+	return ""
+}
+
+func (a *App) add() {
+	a.dialog = &dialog{prompt: "New site", value: a.defaultGroup(), done: func(name string) {
+		name = pio.CleanPath(name)
+		if name == "" {
+			return
+		}
+		if _, exists := a.vaultSite(name); exists {
+			a.status = fmt.Sprintf("A site called %s already exists", name)
+			return
+		}
+		a.dialog = &dialog{prompt: "Password for " + name, secret: true, done: func(pass string) {
+			if err := insert.SealPassword(name, pass, pio.Metadata{}); err != nil {
+				a.status = fmt.Sprintf("Could not add %s: %s", name, err.Error())
+				return
+			}
+			a.status = "Added " + name
+			a.filter = ""
+			a.reload()
+			a.selectPath(name)
+		}}
+	}}
+}
+
+func (a *App) editSite() {
+	site, ok := a.currentSite()
+	if !ok {
+		return
+	}
+	if site.IsFile {
+		a.status = "File entries can not be edited here. Use passgo edit -e"
+		return
+	}
+	if site.SSHPublicKey != "" {
+		a.status = "SSH keys can not be edited"
+		return
+	}
+	a.dialog = &dialog{prompt: "New password for " + site.Name, secret: true, done: func(pass string) {
+		if err := edit.Replace(site.Name, pass); err != nil {
+			a.status = fmt.Sprintf("Could not change the password of %s: %s", site.Name, err.Error())
+			return
+		}
+		a.status = "Changed the password of " + site.Name
+		a.reload()
+		a.selectPath(site.Name)
+	}}
+}
+
+func (a *App) rename() {
+	line, ok := a.current()
+	if !ok {
+		return
+	}
+	a.dialog = &dialog{prompt: "Rename " + line.Path + " to", value: line.Path, done: func(name string) {
+		if err := edit.Move(line.Path, name); err != nil {
+			a.status = fmt.Sprintf("Could not rename %s: %s", line.Path, err.Error())
+			return
+		}
+		a.status = fmt.Sprintf("Renamed %s to %s", line.Path, name)
+		a.reload()
+		a.selectPath(pio.CleanPath(name))
+	}}
+}
+
+func (a *App) remove() {
+	site, ok := a.currentSite()
+	if !ok {
+		return
+	}
+	a.dialog = &dialog{prompt: fmt.Sprintf("Remove %s? (y/n)", site.Name), confirm: true, done: func(answer string) {
+		if answer != "y" {
+			return
+		}
+		if err := edit.Remove(site.Name); err != nil {
+			a.status = fmt.Sprintf("Could not remove %s: %s", site.Name, err.Error())
+			return
+		}
+		a.status = "Removed " + site.Name
+		a.reload()
+	}}
+}
+
+// vaultSite looks up a site by name in the whole vault, ignoring the filter.
+func (a *App) vaultSite(name string) (pio.SiteInfo, bool) {
+	for _, site := range pio.GetVault() {
+		if site.Name == name {
+			return site, true
+		}
+	}
+	return pio.SiteInfo{}, false
+}
+
+func (a *App) selectPath(path string) {
+	for i, line := range a.lines {
+		if line.Path == path {
+			a.selected = i
+			return
+		}
+	}
+}
+
+func (a *App) handleDialog(ev *tcell.EventKey) {
+	d := a.dialog
+	if d.confirm {
+		a.dialog = nil
+		answer := "n"
+		if ev.Key() == tcell.KeyRune && (ev.Rune() == 'y' || ev.Rune() == 'Y') {
+			answer = "y"
+		}
+		d.done(answer)
+		return
+	}
+	switch ev.Key() {
+	case tcell.KeyEscape, tcell.KeyCtrlC:
+		a.dialog = nil
+	case tcell.KeyEnter:
+		a.dialog = nil
+		d.done(d.value)
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if d.value != "" {
+			_, size := utf8.DecodeLastRuneInString(d.value)
+			d.value = d.value[:len(d.value)-size]
+		}
+	case tcell.KeyCtrlU:
+		d.value = ""
+	case tcell.KeyRune:
+		d.value += string(ev.Rune())
+	}
+}
+
+// listHeight is the number of lines available for the tree.
+func (a *App) listHeight() int {
+	_, h := a.screen.Size()
+	// The filter, details, help and separator take up the other lines.
+	if h -= 6; h < 1 {
+		h = 1
+	}
+	return h
+}
+
+func (a *App) draw() {
+	a.screen.Clear()
+	w, h := a.screen.Size()
+	a.text(0, 0, w, styleDefault, "Filter: "+a.filter)
+	a.screen.ShowCursor(len("Filter: ")+utf8.RuneCountInString(a.filter), 0)
+
+	// Scroll so that the selected line is visible.
+	listHeight := a.listHeight()
+	if a.selected < a.top {
+		a.top = a.selected
+	}
+	if a.selected >= a.top+listHeight {
+		a.top = a.selected - listHeight + 1
+	}
+	for i := 0; i < listHeight && a.top+i < len(a.lines); i++ {
+		line := a.lines[a.top+i]
+		style := styleDefault
+		if !line.Site {
+			style = styleGroup
+		}
+		if a.top+i == a.selected {
+			style = styleSelected
+		}
+		x := a.text(0, i+1, w, styleDefault, line.Prefix)
+		a.text(x, i+1, w-x, style, line.Name)
+	}
+	if len(a.lines) == 0 {
+		a.text(0, 1, w, styleStatus, "No sites found")
+	}
+
+	a.text(0, h-5, w, styleStatus, strings.Repeat("─", w))
+	a.drawDetails(h-4, w)
+	status := a.status
+	if status == "" {
+		status = help
+	}
+	a.text(0, h-1, w, styleStatus, status)
+
+	if a.dialog != nil {
+		a.drawDialog(w, h)
+	}
+	a.screen.Show()
+}
+
+func (a *App) drawDetails(y, w int) {
+	line, ok := a.current()
+	if !ok {
+		return
+	}
+	if !line.Site {
+		a.text(0, y, w, styleDefault, "Group: "+line.Path)
+		return
+	}
+	site := a.sites[line.Path]
+	a.text(0, y, w, styleDefault, "Site: "+site.Name)
+	var fields []string
+	if site.URL != "" {
+		fields = append(fields, "URL: "+site.URL)
+	}
+	if site.Username != "" {
+		fields = append(fields, "Username: "+site.Username)
+	}
+	if len(site.Tags) > 0 {
+		fields = append(fields, "Tags: "+strings.Join(site.Tags, ", "))
+	}
+	a.text(0, y+1, w, styleDefault, strings.Join(fields, "  "))
+	secret := mask
+	if a.revealed != "" {
+		// Multi-line entries are shown on one line.
+		secret = strings.Replace(a.revealed, "\n", "⏎", -1)
+	}
+	label := "Password: "
+	if site.IsFile {
+		label = "File: "
+	}
+	a.text(0, y+2, w, styleDefault, label+secret)
+}
+
+func (a *App) drawDialog(w, h int) {
+	d := a.dialog
+	value := d.value
+	if d.secret {
+		value = strings.Repeat("*", utf8.RuneCountInString(value))
+	}
+	text := d.prompt + ": " + value
+	if d.confirm {
+		text = d.prompt
+	}
+	width := utf8.RuneCountInString(text) + 4
+	if width < 40 {
+		width = 40
+	}
+	if width > w {
+		width = w
+	}
+	x, y := (w-width)/2, h/2-1
+	for i := 0; i < 3; i++ {
+		a.text(x, y+i, width, styleSelected, strings.Repeat(" ", width))
+	}
+	end := a.text(x+2, y+1, width-4, styleSelected, text)
+	if !d.confirm {
+		a.screen.ShowCursor(end, y+1)
+	}
+}
+
+// text draws s at x, y, cut off after width cells, and returns the x
+// coordinate after it.
+func (a *App) text(x, y, width int, style tcell.Style, s string) int {
+	end := x + width
+	for _, r := range s {
+		if x >= end {
+			break
+		}
+		a.screen.SetContent(x, y, r, nil, style)
+		x++
+	}
+	return x
+}
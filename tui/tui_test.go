@@ -0,0 +1,241 @@
+package tui
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ejcx/passgo/v2/insert"
+	"github.com/ejcx/passgo/v2/internal/vaulttest"
+	"github.com/ejcx/passgo/v2/pio"
+	"github.com/gdamore/tcell/v2"
+)
+
+// newApp creates a vault holding a few sites and returns the interface
+// for it on a simulated 80x24 screen, with the clipboard replaced by
+// *copied.
+func newApp(t *testing.T, copied *string) (app *App, screen tcell.SimulationScreen, cleanup func()) {
+	_, removeVault := vaulttest.New(t)
+	vaulttest.AddPassword(t, "money/bank.com", "hunter2", pio.Metadata{Username: "alice"})
+	vaulttest.AddPassword(t, "money/mint.com", "mintpass", pio.Metadata{})
+	vaulttest.AddPassword(t, "email/gmail.com", "gmailpass", pio.Metadata{})
+	key := vaulttest.Unlock()
+
+	screen = tcell.NewSimulationScreen("")
+	if err := screen.Init(); err != nil {
+		t.Fatalf("Could not init screen: %s", err)
+	}
+	screen.SetSize(80, 24)
+	app = New(screen, key)
+	app.copy = func(s string) error {
+		*copied = s
+		return nil
+	}
+	return app, screen, func() {
+		screen.Fini()
+		removeVault()
+	}
+}
+
+// contents returns the text on the screen, one string per row.
+func contents(screen tcell.SimulationScreen) string {
+	cells, w, _ := screen.GetContents()
+	var b strings.Builder
+	for i, c := range cells {
+		if len(c.Runes) > 0 {
+			b.WriteRune(c.Runes[0])
+		} else {
+			b.WriteByte(' ')
+		}
+		if i%w == w-1 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+func press(app *App, key tcell.Key) bool {
+	ok := app.handle(tcell.NewEventKey(key, 0, tcell.ModNone))
+	app.draw()
+	return ok
+}
+
+func typeText(app *App, s string) {
+	for _, r := range s {
+		app.handle(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+	}
+	app.draw()
+}
+
+func sites() []string {
+	var names []string
+	for _, site := range pio.GetVault() {
+		names = append(names, site.Name)
+	}
+	return names
+}
+
+func TestFilterRevealCopy(t *testing.T) {
+	var copied string
+	app, screen, cleanup := newApp(t, &copied)
+	defer cleanup()
+	app.draw()
+
+	if out := contents(screen); !strings.Contains(out, "gmail.com") || !strings.Contains(out, "bank.com") {
+		t.Fatalf("Vault not shown:\n%s", out)
+	}
+	typeText(app, "mnt")
+	out := contents(screen)
+	if strings.Contains(out, "gmail.com") || !strings.Contains(out, "mint.com") {
+		t.Fatalf("Filter \"mnt\" not applied:\n%s", out)
+	}
+	if site, _ := app.currentSite(); site.Name != "money/mint.com" {
+		t.Fatalf("Filter selected %q, expected money/mint.com", site.Name)
+	}
+	if strings.Contains(out, "mintpass") {
+		t.Fatalf("Password shown before it was revealed:\n%s", out)
+	}
+	press(app, tcell.KeyEnter)
+	if out := contents(screen); !strings.Contains(out, "mintpass") {
+		t.Fatalf("Password not revealed:\n%s", out)
+	}
+	press(app, tcell.KeyCtrlY)
+	if copied != "mintpass" {
+		t.Errorf("Copied %q, expected mintpass", copied)
+	}
+
+	press(app, tcell.KeyEscape)
+	typeText(app, "bank")
+	press(app, tcell.KeyCtrlU)
+	if copied != "alice" {
+		t.Errorf("Copied username %q, expected alice", copied)
+	}
+	if out := contents(screen); !strings.Contains(out, "alice") {
+		t.Errorf("Username not shown in details:\n%s", out)
+	}
+}
+
+func TestLargeFile(t *testing.T) {
+	var copied string
+	app, _, cleanup := newApp(t, &copied)
+	defer cleanup()
+
+	f, err := ioutil.TempFile("", "passgo-large")
+	if err != nil {
+		t.Fatalf("Could not create temp file: %s", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(strings.Repeat("a", maxReveal+1))
+	f.Close()
+	insert.File("files/large.txt", f.Name(), "", pio.Metadata{})
+	app.reload()
+
+	typeText(app, "large")
+	press(app, tcell.KeyEnter)
+	if app.revealed != "" || !strings.HasPrefix(app.status, "File is too large") {
+		t.Errorf("Large file revealed, status is %q", app.status)
+	}
+	press(app, tcell.KeyCtrlY)
+	if copied != "" || !strings.HasPrefix(app.status, "File is too large") {
+		t.Errorf("Large file copied, status is %q", app.status)
+	}
+}
+
+func TestAddRenameRemove(t *testing.T) {
+	var copied string
+	app, _, cleanup := newApp(t, &copied)
+	defer cleanup()
+
+	typeText(app, "bank")
+	press(app, tcell.KeyCtrlA)
+	if app.dialog == nil || app.dialog.value != "money/" {
+		t.Fatalf("Add dialog did not default to the selected group")
+	}
+	typeText(app, "credit.com")
+	press(app, tcell.KeyEnter)
+	typeText(app, "cardpass")
+	press(app, tcell.KeyEnter)
+	if site, _ := app.currentSite(); site.Name != "money/credit.com" {
+		t.Fatalf("Added site not selected, selected %q", site.Name)
+	}
+	press(app, tcell.KeyCtrlY)
+	if copied != "cardpass" {
+		t.Fatalf("Added site has password %q", copied)
+	}
+
+	press(app, tcell.KeyCtrlE)
+	typeText(app, "newpass")
+	press(app, tcell.KeyEnter)
+	press(app, tcell.KeyCtrlY)
+	if copied != "newpass" {
+		t.Fatalf("Edited site has password %q", copied)
+	}
+
+	press(app, tcell.KeyCtrlR)
+	press(app, tcell.KeyCtrlU)
+	typeText(app, "cards/visa.com")
+	press(app, tcell.KeyEnter)
+	if site, _ := app.currentSite(); site.Name != "cards/visa.com" {
+		t.Fatalf("Renamed site not selected, selected %q", site.Name)
+	}
+
+	press(app, tcell.KeyCtrlD)
+	typeText(app, "n")
+	if _, ok := app.vaultSite("cards/visa.com"); !ok {
+		t.Fatalf("Site removed without confirmation")
+	}
+	press(app, tcell.KeyCtrlD)
+	typeText(app, "y")
+	if _, ok := app.vaultSite("cards/visa.com"); ok {
+		t.Fatalf("Site not removed, vault has %v", sites())
+	}
+	if got := len(sites()); got != 3 {
+		t.Errorf("Vault has %d sites, expected 3: %v", got, sites())
+	}
+}
+
+func TestAddError(t *testing.T) {
+	var copied string
+	app, screen, cleanup := newApp(t, &copied)
+	defer cleanup()
+
+	// The error is shown instead of exiting when the site can not be
+	// saved.
+	defer vaulttest.BreakConfig(t)()
+	press(app, tcell.KeyCtrlA)
+	press(app, tcell.KeyCtrlU)
+	typeText(app, "money")
+	press(app, tcell.KeyEnter)
+	typeText(app, "pass")
+	press(app, tcell.KeyEnter)
+	if !strings.HasPrefix(app.status, "Could not add money: ") {
+		t.Fatalf("Error not shown, status is %q", app.status)
+	}
+	if !strings.Contains(contents(screen), "Could not add money") {
+		t.Errorf("Error not drawn on the status line")
+	}
+	if _, ok := app.vaultSite("money"); ok {
+		t.Errorf("Site added despite the error")
+	}
+}
+
+func TestQuit(t *testing.T) {
+	var copied string
+	app, screen, cleanup := newApp(t, &copied)
+	defer cleanup()
+
+	// Esc clears the filter first, then quits.
+	screen.InjectKey(tcell.KeyRune, 'b', tcell.ModNone)
+	screen.InjectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	screen.InjectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	if err := app.Run(); err != nil {
+		t.Fatalf("Run returned %s", err)
+	}
+	if app.filter != "" {
+		t.Errorf("Filter %q not cleared", app.filter)
+	}
+	if press(app, tcell.KeyCtrlC) {
+		t.Errorf("Ctrl-C did not quit")
+	}
+}